@@ -1,20 +1,48 @@
 package auth
 
 import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+
 	"github.com/labstack/echo/v4"
 	appcontext "undef.ninja/x/feedaka/context"
+	"undef.ninja/x/feedaka/db"
 )
 
-// SessionAuthMiddleware validates session and adds user info to context
-func SessionAuthMiddleware(sessionConfig *SessionConfig) echo.MiddlewareFunc {
+// SessionAuthMiddleware validates session or API token and adds user info to context
+func SessionAuthMiddleware(sessionConfig *SessionConfig, queries db.Store) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			// An API token takes precedence over the session
+			if token, ok := bearerToken(c.Request()); ok {
+				t, err := AuthenticateAPIToken(c.Request().Context(), queries, token)
+				if err != nil {
+					if errors.Is(err, ErrInvalidAPIToken) {
+						return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+					}
+					return err
+				}
+				ctx := appcontext.SetUserID(c.Request().Context(), t.UserID)
+				ctx = appcontext.SetAPITokenScope(ctx, t.Scope)
+				c.SetRequest(c.Request().WithContext(ctx))
+				return next(c)
+			}
+
 			// Try to get user ID from session
 			userID, err := sessionConfig.GetUserID(c)
 			if err == nil {
-				// Add user ID to context
-				ctx := appcontext.SetUserID(c.Request().Context(), userID)
-				c.SetRequest(c.Request().WithContext(ctx))
+				// Sessions of users disabled or deleted after they logged in are ignored
+				user, err := queries.GetUserByID(c.Request().Context(), userID)
+				if err != nil && err != sql.ErrNoRows {
+					return err
+				}
+				if err == nil && user.Disabled == 0 {
+					// Add user ID to context
+					ctx := appcontext.SetUserID(c.Request().Context(), userID)
+					c.SetRequest(c.Request().WithContext(ctx))
+				}
 			}
 			// If no valid session, continue without authentication
 
@@ -22,3 +50,16 @@ func SessionAuthMiddleware(sessionConfig *SessionConfig) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// IsSameOrigin reports whether the request's Origin header, if any, matches the requested host.
+func IsSameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Host == r.Host
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+const (
+	ScopeReadOnly  = "read_only"
+	ScopeReadWrite = "read_write"
+)
+
+// Prefix of API tokens, which makes leaked tokens easy to recognize
+const apiTokenPrefix = "feedaka_"
+
+// last_used_at is updated at most once per this interval to avoid a write on every request
+const lastUsedInterval = time.Minute
+
+var ErrInvalidAPIToken = errors.New("invalid or expired API token")
+
+func IsValidScope(scope string) bool {
+	return scope == ScopeReadOnly || scope == ScopeReadWrite
+}
+
+// GenerateAPIToken returns a new random API token and the hash to store in the database.
+func GenerateAPIToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = apiTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashAPIToken(token), nil
+}
+
+// HashAPIToken returns the SHA-256 hash of the token. Tokens have enough entropy that a slow hash is unnecessary.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuthenticateAPIToken returns the stored token if it exists and has not expired, and records its use.
+func AuthenticateAPIToken(ctx context.Context, queries db.Store, token string) (db.ApiToken, error) {
+	t, err := queries.GetAPITokenByHash(ctx, HashAPIToken(token))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.ApiToken{}, ErrInvalidAPIToken
+		}
+		return db.ApiToken{}, err
+	}
+
+	now := time.Now().UTC()
+	if t.ExpiresAt.Valid {
+		expiresAt, err := time.Parse(time.RFC3339, t.ExpiresAt.String)
+		if err != nil || !now.Before(expiresAt) {
+			return db.ApiToken{}, ErrInvalidAPIToken
+		}
+	}
+
+	err = queries.UpdateAPITokenLastUsed(ctx, db.UpdateAPITokenLastUsedParams{
+		LastUsedAt: sql.NullString{String: now.Format(time.RFC3339), Valid: true},
+		ID:         t.ID,
+		Threshold:  sql.NullString{String: now.Add(-lastUsedInterval).Format(time.RFC3339), Valid: true},
+	})
+	if err != nil {
+		return db.ApiToken{}, err
+	}
+	return t, nil
+}
+
+// bearerToken returns the token in the "Authorization: Bearer <token>" header, if any.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,146 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+// Names of the backups made by Rotate, which sort by time
+const (
+	rotatedPrefix = "feedaka-"
+	rotatedSuffix = ".db"
+	timeFormat    = "20060102T150405Z"
+)
+
+// Create writes a backup of the SQLite database to the new file at path. The backup is written to a temporary file
+// first, so that path never holds a partial backup. Backups contain password hashes and credentials, so only the
+// owner can read them.
+func Create(ctx context.Context, database *db.DB, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup file already exists: %s", path)
+	}
+	tmp := path + ".tmp"
+	// Left over by an interrupted backup
+	if err := os.Remove(tmp); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := database.Backup(ctx, tmp); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Chmod(tmp, 0o600); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Rotate writes a backup named by the current time to dir, and then deletes all but the newest keep backups there.
+// It returns the path of the new backup.
+func Rotate(ctx context.Context, database *db.DB, dir string, keep int) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	path := filepath.Join(dir, rotatedPrefix+time.Now().UTC().Format(timeFormat)+rotatedSuffix)
+	if err := Create(ctx, database, path); err != nil {
+		return "", err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return path, fmt.Errorf("failed to list backups: %w", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		// Other files in the directory are left alone
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, rotatedPrefix) && strings.HasSuffix(name, rotatedSuffix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for i := 0; i < len(names)-keep; i++ {
+		if err := os.Remove(filepath.Join(dir, names[i])); err != nil {
+			return path, fmt.Errorf("failed to delete old backup: %w", err)
+		}
+	}
+	return path, nil
+}
+
+// Restore replaces the SQLite database at dbPath by a copy of the backup at src, after checking that the backup is
+// intact and has the expected schema version. Nothing may use the database meanwhile. The replaced database is kept
+// next to it, and its path is returned, or "" if there was no database.
+func Restore(ctx context.Context, dbPath, src string) (string, error) {
+	if err := db.ValidateBackup(src); err != nil {
+		return "", fmt.Errorf("invalid backup: %w", err)
+	}
+
+	// Copy next to the database first, so that the database is replaced by a rename
+	tmp := dbPath + ".restore"
+	if err := copyFile(src, tmp); err != nil {
+		return "", fmt.Errorf("failed to copy backup: %w", err)
+	}
+	defer os.Remove(tmp)
+
+	var saved string
+	if _, err := os.Stat(dbPath); err == nil {
+		// Move the changes in the WAL into the database file, so that the kept file is complete without the WAL
+		if err := checkpoint(ctx, dbPath); err != nil {
+			return "", fmt.Errorf("failed to checkpoint database: %w", err)
+		}
+		saved = dbPath + ".before-restore-" + time.Now().UTC().Format(timeFormat)
+		if err := os.Rename(dbPath, saved); err != nil {
+			return "", fmt.Errorf("failed to move database: %w", err)
+		}
+	}
+	// SQLite would apply the journals of the replaced database to the restored one
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			return saved, err
+		}
+	}
+	if err := os.Rename(tmp, dbPath); err != nil {
+		return saved, fmt.Errorf("failed to move backup into place: %w", err)
+	}
+	return saved, nil
+}
+
+func checkpoint(ctx context.Context, dbPath string) error {
+	database, err := db.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+	_, err = database.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)")
+	return err
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	// The restored database must be on disk before the old one is moved away
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"undef.ninja/x/feedaka/backup"
+	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/logging"
+)
+
+const backupUsage = `Usage: feedaka [global flags] backup <path>
+
+Back up the SQLite database to a new file at <path>. The server may keep running.
+`
+
+const restoreUsage = `Usage: feedaka [global flags] restore [-yes] <path>
+
+Replace the SQLite database by the backup at <path>. The backup must have the schema version this build expects.
+Stop the server, workers and anything else using the database first. The replaced database is kept next to it.
+`
+
+// RunBackup runs the "backup" command.
+func RunBackup(database *db.DB, args []string) {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+	path := parseArgs(fs, args, backupUsage, 1)[0]
+
+	if err := db.ValidateSchemaVersion(database); err != nil {
+		logging.Fatal("Invalid database schema", "error", err)
+	}
+	if err := backup.Create(context.Background(), database, path); err != nil {
+		logging.Fatal("Failed to back up database", "error", err)
+	}
+	slog.Info("Backup created", "path", path)
+}
+
+// RunRestore runs the "restore" command.
+func RunRestore(database *db.DB, cfg *config.Config, args []string) {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	yes := fs.Bool("yes", false, "Do not ask for confirmation. Required if stdin is not a terminal.")
+	src := parseArgs(fs, args, restoreUsage, 1)[0]
+
+	if database.Dialect != db.DialectSQLite {
+		logging.Fatal("Failed to restore database", "error", db.ErrBackupUnsupported)
+	}
+	// The database is replaced by moving files, so no connection may stay open
+	database.Close()
+
+	question := fmt.Sprintf("Replace %s by %s? Nothing may use the database meanwhile.", cfg.DatabasePath, src)
+	if !*yes && !confirm(bufio.NewReader(os.Stdin), question) {
+		logging.Fatal("Restore not confirmed. Pass -yes to restore without confirmation.")
+	}
+
+	saved, err := backup.Restore(context.Background(), cfg.DatabasePath, src)
+	if err != nil {
+		logging.Fatal("Failed to restore database", "error", err)
+	}
+	if saved != "" {
+		slog.Info("Database restored", "path", cfg.DatabasePath, "backup", src, "replaced_database", saved)
+	} else {
+		slog.Info("Database restored", "path", cfg.DatabasePath, "backup", src)
+	}
+}
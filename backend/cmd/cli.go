@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/logging"
+)
+
+// newFetcher creates the fetcher all requests to feeds and their sites go through.
+func newFetcher(cfg *config.Config) (*feed.Fetcher, error) {
+	return feed.NewFetcher(feed.FetcherConfig{
+		ConnectTimeout: cfg.FetchConnectTimeout,
+		Timeout:        cfg.FetchTimeout,
+		MaxSize:        cfg.FetchMaxSize,
+		UserAgent:      cfg.FetchUserAgent,
+		Proxy:          cfg.FetchProxy,
+	})
+}
+
+// parseArgs parses the flags of a subcommand, which may come before or after its arguments. It exits with usage
+// unless there are exactly nargs arguments, or any number if nargs is negative.
+func parseArgs(fs *flag.FlagSet, args []string, usage string, nargs int) []string {
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+	}
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if nargs >= 0 && len(positional) != nargs {
+		fs.Usage()
+		os.Exit(2)
+	}
+	return positional
+}
+
+func lookupUser(ctx context.Context, queries db.Store, username string) db.GetUserByUsernameRow {
+	user, err := queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logging.Fatal("User not found", "username", username)
+		}
+		logging.Fatal("Failed to query user", "error", err)
+	}
+	return user
+}
+
+func printJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		logging.Fatal("Failed to write output", "error", err)
+	}
+}
+
+// printTable writes a table with a header row to stdout. Each row must have the same number of columns as header.
+func printTable(header []string, rows [][]string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeRow(w, header)
+	for _, row := range rows {
+		writeRow(w, row)
+	}
+	if err := w.Flush(); err != nil {
+		logging.Fatal("Failed to write output", "error", err)
+	}
+}
+
+func writeRow(w io.Writer, columns []string) {
+	for i, column := range columns {
+		if i > 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprint(w, column)
+	}
+	fmt.Fprintln(w)
+}
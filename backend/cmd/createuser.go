@@ -5,58 +5,69 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strings"
 
-	"golang.org/x/crypto/bcrypt"
-
+	"undef.ninja/x/feedaka/auth"
 	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/logging"
 )
 
-func RunCreateUser(database *sql.DB) {
-	queries := db.New(database)
+// RunCreateUser creates a user with the username and password read from stdin. It is kept for -create-user;
+// "user create" takes the username as an argument.
+func RunCreateUser(database *db.DB) {
+	queries := db.NewStore(database, nil)
 	reader := bufio.NewReader(os.Stdin)
 
 	// Read username
-	fmt.Print("Enter username: ")
-	username, err := reader.ReadString('\n')
+	if stdinIsTerminal() {
+		fmt.Fprint(os.Stderr, "Enter username: ")
+	}
+	username, err := readLine(reader)
 	if err != nil {
-		log.Fatalf("Failed to read username: %v", err)
+		logging.Fatal("Failed to read username", "error", err)
 	}
-	username = strings.TrimSpace(username)
-	if username == "" {
-		log.Fatal("Username cannot be empty")
+
+	password, err := readPassword(reader, "")
+	if err != nil {
+		logging.Fatal("Failed to read password", "error", err)
 	}
 
-	// Read password
-	fmt.Print("Enter password: ")
-	password, err := reader.ReadString('\n')
+	user, err := createUser(context.Background(), queries, username, password)
 	if err != nil {
-		log.Fatalf("Failed to read password: %v", err)
+		logging.Fatal("Failed to create user", "error", err)
 	}
-	password = strings.TrimSpace(password)
+	slog.Info("User created", "user_id", user.ID, "username", user.Username)
+}
 
-	// Validate password length
-	if len(password) < 15 {
-		log.Fatalf("Password must be at least 15 characters long (got %d characters)", len(password))
+func createUser(ctx context.Context, queries db.Store, username, password string) (db.User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return db.User{}, fmt.Errorf("username cannot be empty")
+	}
+	if err := checkUsernameAvailable(ctx, queries, username); err != nil {
+		return db.User{}, err
 	}
 
-	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := auth.HashPassword(password)
 	if err != nil {
-		log.Fatalf("Failed to hash password: %v", err)
+		return db.User{}, fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	// Create user
-	ctx := context.Background()
-	user, err := queries.CreateUser(ctx, db.CreateUserParams{
+	return queries.CreateUser(ctx, db.CreateUserParams{
 		Username:     username,
-		PasswordHash: string(hashedPassword),
+		PasswordHash: hashedPassword,
 	})
-	if err != nil {
-		log.Fatalf("Failed to create user: %v", err)
-	}
+}
 
-	log.Printf("User created successfully: ID=%d, Username=%s", user.ID, user.Username)
+func checkUsernameAvailable(ctx context.Context, queries db.Store, username string) error {
+	_, err := queries.GetUserByUsername(ctx, username)
+	if err == nil {
+		return fmt.Errorf("username already exists: %s", username)
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("failed to query user: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,386 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
+)
+
+const feedsUsage = `Usage: feedaka [global flags] feeds <command> -user <username> [-json] [arguments]
+
+Commands:
+  list                 List the user's feeds
+  show <feed>          Show a feed in detail
+  add <url>            Subscribe to a feed. It is fetched and its articles are stored right away.
+  remove <feed>        Unsubscribe from a feed
+  refresh [<feed>...]  Fetch feeds now. Without arguments, all feeds of the user are fetched.
+
+<feed> is a feed ID or URL. -json prints JSON instead of a table.
+`
+
+// feedsCommand is the state shared by the "feeds" subcommands.
+type feedsCommand struct {
+	cfg     *config.Config
+	queries db.Store
+	user    db.GetUserByUsernameRow
+	asJSON  bool
+}
+
+// RunFeeds runs the "feeds" subcommands, which manage the feeds of a user directly in the database. Adding and
+// refreshing feeds fetch them from this process, so they work while the server is down.
+func RunFeeds(database *db.DB, cfg *config.Config, args []string) {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, feedsUsage)
+		os.Exit(2)
+	}
+	command, args := args[0], args[1:]
+
+	var run func(ctx context.Context, args []string)
+	var nargs int
+	c := &feedsCommand{cfg: cfg}
+	switch command {
+	case "list":
+		run, nargs = c.list, 0
+	case "show":
+		run, nargs = c.show, 1
+	case "add":
+		run, nargs = c.add, 1
+	case "remove":
+		run, nargs = c.remove, 1
+	case "refresh":
+		run, nargs = c.refresh, -1
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(os.Stdout, feedsUsage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown feeds command: %s\n\n%s", command, feedsUsage)
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("feeds "+command, flag.ExitOnError)
+	username := fs.String("user", "", "Owner of the feeds (required)")
+	fs.BoolVar(&c.asJSON, "json", false, "Print JSON instead of a table")
+	args = parseArgs(fs, args, feedsUsage, nargs)
+	if *username == "" {
+		fmt.Fprint(os.Stderr, "-user is required\n\n"+feedsUsage)
+		os.Exit(2)
+	}
+
+	if err := db.ValidateSchemaVersion(database); err != nil {
+		logging.Fatal("Invalid database schema", "error", err)
+	}
+
+	ctx := context.Background()
+	c.queries = db.NewStore(database, nil)
+	c.user = lookupUser(ctx, c.queries, *username)
+	run(ctx, args)
+}
+
+type feedJSON struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	FetchedAt   string `json:"fetched_at"`
+	UnreadCount int64  `json:"unread_count"`
+}
+
+type feedDetailJSON struct {
+	feedJSON
+	ArticleCount     int  `json:"article_count"`
+	FetchFullContent bool `json:"fetch_full_content"`
+	// 0 means the configured default
+	FetchTimeoutSeconds int64        `json:"fetch_timeout_seconds"`
+	HasCredentials      bool         `json:"has_credentials"`
+	Scraper             *scraperJSON `json:"scraper,omitempty"`
+	WebSubState         string       `json:"websub_state,omitempty"`
+}
+
+type scraperJSON struct {
+	Item    string `json:"item"`
+	Title   string `json:"title,omitempty"`
+	Link    string `json:"link,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+type refreshResultJSON struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	NewArticles int    `json:"new_articles"`
+	Error       string `json:"error,omitempty"`
+}
+
+var feedColumns = []string{"ID", "TITLE", "URL", "UNREAD", "FETCHED AT"}
+
+func (c *feedsCommand) unreadCounts(ctx context.Context) map[int64]int64 {
+	rows, err := c.queries.GetUnreadCountsByFeed(ctx, c.user.ID)
+	if err != nil {
+		logging.Fatal("Failed to query unread counts", "error", err)
+	}
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.FeedID] = row.UnreadCount
+	}
+	return counts
+}
+
+func (c *feedsCommand) printFeeds(ctx context.Context, feeds []db.Feed) {
+	counts := c.unreadCounts(ctx)
+	if c.asJSON {
+		result := make([]feedJSON, 0, len(feeds))
+		for _, f := range feeds {
+			result = append(result, feedToJSON(f, counts[f.ID]))
+		}
+		printJSON(result)
+		return
+	}
+
+	rows := make([][]string, 0, len(feeds))
+	for _, f := range feeds {
+		rows = append(rows, []string{
+			strconv.FormatInt(f.ID, 10),
+			f.Title,
+			f.Url,
+			strconv.FormatInt(counts[f.ID], 10),
+			f.FetchedAt,
+		})
+	}
+	printTable(feedColumns, rows)
+}
+
+func feedToJSON(f db.Feed, unreadCount int64) feedJSON {
+	return feedJSON{
+		ID:          f.ID,
+		Title:       f.Title,
+		URL:         f.Url,
+		FetchedAt:   f.FetchedAt,
+		UnreadCount: unreadCount,
+	}
+}
+
+// lookupFeed returns the subscribed feed of the user whose ID or URL is arg.
+func (c *feedsCommand) lookupFeed(ctx context.Context, arg string) db.Feed {
+	var f db.Feed
+	var err error
+	if id, parseErr := strconv.ParseInt(arg, 10, 64); parseErr == nil {
+		f, err = c.queries.GetFeed(ctx, id)
+	} else {
+		f, err = c.queries.GetFeedByURL(ctx, db.GetFeedByURLParams{Url: arg, UserID: c.user.ID})
+	}
+	if err != nil && err != sql.ErrNoRows {
+		logging.Fatal("Failed to query feed", "error", err)
+	}
+	if err == sql.ErrNoRows || f.UserID != c.user.ID || f.IsSubscribed != 1 {
+		logging.Fatal("Feed not found", "feed", arg, "username", c.user.Username)
+	}
+	return f
+}
+
+// newSubscriber returns the WebSub subscriber, which is nil unless the server knows its public URL.
+func (c *feedsCommand) newSubscriber(fetcher *feed.Fetcher) *websub.Subscriber {
+	if c.cfg.BaseURL == "" {
+		return nil
+	}
+	// Nobody listens to events in this process
+	return websub.NewSubscriber(c.queries, pubsub.NewBus(), c.cfg.BaseURL, fetcher)
+}
+
+func (c *feedsCommand) list(ctx context.Context, _ []string) {
+	feeds, err := c.queries.GetFeeds(ctx, c.user.ID)
+	if err != nil {
+		logging.Fatal("Failed to query feeds", "error", err)
+	}
+	c.printFeeds(ctx, feeds)
+}
+
+func (c *feedsCommand) show(ctx context.Context, args []string) {
+	f := c.lookupFeed(ctx, args[0])
+
+	articles, err := c.queries.GetArticlesByFeed(ctx, f.ID)
+	if err != nil {
+		logging.Fatal("Failed to query articles", "error", err)
+	}
+	var unreadCount int64
+	for _, a := range articles {
+		if a.IsRead == 0 {
+			unreadCount++
+		}
+	}
+	hasCredentials, err := c.queries.CheckFeedCredentialsExist(ctx, f.ID)
+	if err != nil {
+		logging.Fatal("Failed to query feed credentials", "error", err)
+	}
+	scraper, err := feed.GetSelectors(ctx, c.queries, f.ID)
+	if err != nil {
+		logging.Fatal("Failed to query feed scraper", "error", err)
+	}
+	var websubState string
+	sub, err := c.queries.GetWebSubSubscription(ctx, f.ID)
+	if err == nil {
+		websubState = sub.State
+	} else if err != sql.ErrNoRows {
+		logging.Fatal("Failed to query WebSub subscription", "error", err)
+	}
+
+	detail := feedDetailJSON{
+		feedJSON:            feedToJSON(f, unreadCount),
+		ArticleCount:        len(articles),
+		FetchFullContent:    f.FetchFullContent == 1,
+		FetchTimeoutSeconds: f.FetchTimeoutSeconds,
+		HasCredentials:      hasCredentials == 1,
+		WebSubState:         websubState,
+	}
+	if scraper != nil {
+		detail.Scraper = &scraperJSON{
+			Item:    scraper.Item,
+			Title:   scraper.Title,
+			Link:    scraper.Link,
+			Date:    scraper.Date,
+			Content: scraper.Content,
+		}
+	}
+	if c.asJSON {
+		printJSON(detail)
+		return
+	}
+
+	timeout := "default"
+	if detail.FetchTimeoutSeconds > 0 {
+		timeout = fmt.Sprintf("%ds", detail.FetchTimeoutSeconds)
+	}
+	scraperItem := "-"
+	if scraper != nil {
+		scraperItem = scraper.Item
+	}
+	if websubState == "" {
+		websubState = "-"
+	}
+	printTable([]string{"FIELD", "VALUE"}, [][]string{
+		{"ID", strconv.FormatInt(detail.ID, 10)},
+		{"Title", detail.Title},
+		{"URL", detail.URL},
+		{"Fetched at", detail.FetchedAt},
+		{"Articles", strconv.Itoa(detail.ArticleCount)},
+		{"Unread", strconv.FormatInt(detail.UnreadCount, 10)},
+		{"Fetch full content", strconv.FormatBool(detail.FetchFullContent)},
+		{"Fetch timeout", timeout},
+		{"Credentials", strconv.FormatBool(detail.HasCredentials)},
+		{"Scraper item selector", scraperItem},
+		{"WebSub", websubState},
+	})
+}
+
+func (c *feedsCommand) add(ctx context.Context, args []string) {
+	fetcher, err := newFetcher(c.cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
+
+	// Same checks and initial sync as the addFeed mutation
+	dbFeed, f, added, err := fetcher.Add(ctx, c.queries, c.user.ID, args[0], feed.AddOptions{})
+	if err != nil {
+		if errors.Is(err, feed.ErrAlreadySubscribed) {
+			logging.Fatal("Already subscribed to this feed", "url", args[0], "username", c.user.Username)
+		}
+		logging.Fatal("Failed to add feed", "error", err)
+	}
+	slog.Info("Feed added", "feed_id", dbFeed.ID, "new_articles", len(added))
+
+	if err := c.newSubscriber(fetcher).Subscribe(ctx, dbFeed.ID, dbFeed.Url, f); err != nil {
+		slog.Warn("Failed to subscribe to WebSub hub", "feed_id", dbFeed.ID, "error", err)
+	}
+	c.printFeeds(ctx, []db.Feed{dbFeed})
+}
+
+func (c *feedsCommand) remove(ctx context.Context, args []string) {
+	f := c.lookupFeed(ctx, args[0])
+
+	if err := c.queries.UnsubscribeFeed(ctx, f.ID); err != nil {
+		logging.Fatal("Failed to unsubscribe from feed", "error", err)
+	}
+	slog.Info("Feed removed", "feed_id", f.ID)
+
+	// Stop receiving pushes for the feed
+	fetcher, err := newFetcher(c.cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
+	if err := c.newSubscriber(fetcher).Unsubscribe(ctx, f.ID); err != nil {
+		slog.Warn("Failed to unsubscribe from WebSub hub", "feed_id", f.ID, "error", err)
+	}
+	c.printFeeds(ctx, []db.Feed{f})
+}
+
+func (c *feedsCommand) refresh(ctx context.Context, args []string) {
+	var feeds []db.Feed
+	if len(args) == 0 {
+		var err error
+		feeds, err = c.queries.GetFeeds(ctx, c.user.ID)
+		if err != nil {
+			logging.Fatal("Failed to query feeds", "error", err)
+		}
+	} else {
+		for _, arg := range args {
+			feeds = append(feeds, c.lookupFeed(ctx, arg))
+		}
+	}
+
+	fetcher, err := newFetcher(c.cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
+	credentials, err := credential.NewStore(c.queries, c.cfg.CredentialsKey)
+	if err != nil {
+		logging.Fatal("Failed to set up credential store", "error", err)
+	}
+	// The feeds are fetched one by one in this process, so the queue only provides Fetch
+	queue := fetchqueue.New(c.queries, fetcher, credentials, c.newSubscriber(fetcher), pubsub.NewBus(), fetchqueue.Config{
+		Workers:      1,
+		JobRetention: c.cfg.FetchJobRetention,
+	})
+
+	results := make([]refreshResultJSON, 0, len(feeds))
+	failed := false
+	for _, f := range feeds {
+		feedCtx := logging.With(ctx, "feed_id", f.ID)
+		added, err := queue.Fetch(feedCtx, f.ID)
+		result := refreshResultJSON{ID: f.ID, Title: f.Title, NewArticles: added}
+		if err != nil {
+			slog.WarnContext(feedCtx, "Failed to fetch feed", "error", err)
+			result.Error = err.Error()
+			failed = true
+		}
+		results = append(results, result)
+	}
+
+	if c.asJSON {
+		printJSON(results)
+	} else {
+		rows := make([][]string, 0, len(results))
+		for _, r := range results {
+			status := "ok"
+			if r.Error != "" {
+				status = r.Error
+			}
+			rows = append(rows, []string{strconv.FormatInt(r.ID, 10), r.Title, strconv.Itoa(r.NewArticles), status})
+		}
+		printTable([]string{"ID", "TITLE", "NEW ARTICLES", "STATUS"}, rows)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
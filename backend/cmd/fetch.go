@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/imageproxy"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
+)
+
+const fetchUsage = `Usage: feedaka [global flags] fetch -once
+
+Fetch the feeds that are due and exit, e.g. from cron. Nothing is fetched if a serve or worker process sharing the
+database fetches feeds on schedule. Run "feedaka worker" to fetch feeds continuously instead.
+`
+
+const workerUsage = `Usage: feedaka [global flags] worker
+
+Fetch feeds on schedule without serving HTTP. Of the serve and worker processes sharing the database, one fetches
+feeds at a time and the others take over if it stops. Run the server with -no-fetch to leave fetching to workers.
+The process fetching on schedule also prunes the image cache, backs up the database and sends digests.
+`
+
+// newFetchQueue creates the queue of a process without the HTTP server. WebSub hubs verify subscriptions through
+// the server, so feeds are subscribed to only if the server's public URL is known.
+func newFetchQueue(queries db.Store, cfg *config.Config, fetcher *feed.Fetcher) *fetchqueue.Queue {
+	credentials, err := credential.NewStore(queries, cfg.CredentialsKey)
+	if err != nil {
+		logging.Fatal("Failed to set up credential store", "error", err)
+	}
+	// Nobody listens to events in this process
+	bus := pubsub.NewBus()
+	var subscriber *websub.Subscriber
+	if cfg.BaseURL != "" {
+		subscriber = websub.NewSubscriber(queries, bus, cfg.BaseURL, fetcher)
+	}
+	return fetchqueue.New(queries, fetcher, credentials, subscriber, bus, fetchqueue.Config{
+		Workers:      int(cfg.FetchWorkers),
+		JobRetention: cfg.FetchJobRetention,
+	})
+}
+
+// RunFetch runs the "fetch" command, which fetches the due feeds once.
+func RunFetch(database *db.DB, cfg *config.Config, args []string) {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	once := fs.Bool("once", false, "Fetch the due feeds and exit (required)")
+	parseArgs(fs, args, fetchUsage, 0)
+	if !*once {
+		fmt.Fprint(os.Stderr, "-once is required\n\n"+fetchUsage)
+		os.Exit(2)
+	}
+
+	if err := db.ValidateSchemaVersion(database); err != nil {
+		logging.Fatal("Invalid database schema", "error", err)
+	}
+
+	// Interrupted fetches are given up and the lease is released
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	fetcher, err := newFetcher(cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
+	queue := newFetchQueue(db.NewStore(database, nil), cfg, fetcher)
+	ran, err := queue.RunOnce(ctx)
+	if err != nil {
+		logging.Fatal("Failed to fetch feeds", "error", err)
+	}
+	if !ran {
+		slog.Info("Another process fetches feeds on schedule; nothing to do")
+	}
+}
+
+// RunWorker runs the "worker" command, which fetches feeds on schedule until it is stopped by a signal.
+func RunWorker(database *db.DB, cfg *config.Config, args []string) {
+	fs := flag.NewFlagSet("worker", flag.ExitOnError)
+	parseArgs(fs, args, workerUsage, 0)
+
+	if err := db.ValidateSchemaVersion(database); err != nil {
+		logging.Fatal("Invalid database schema", "error", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	fetcher, err := newFetcher(cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
+	queries := db.NewStore(database, nil)
+	queue := newFetchQueue(queries, cfg, fetcher)
+	imageProxy := imageproxy.New(cfg.SessionSecret, cfg.ImageCacheDir, cfg.ImageCacheRetention, fetcher.PublicClient())
+	slog.Info("Worker starting", "fetch_interval", cfg.FetchInterval, "workers", cfg.FetchWorkers)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		queue.Run(ctx)
+	}()
+	runMaintenance(ctx, database, queries, cfg, queue, imageProxy)
+	queue.RunScheduler(ctx, cfg.FetchInterval)
+	wg.Wait()
+	slog.Info("Worker stopped")
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"undef.ninja/x/feedaka/backup"
+	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/digest"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/imageproxy"
+	"undef.ninja/x/feedaka/mail"
+)
+
+func scheduled(ctx context.Context, d time.Duration, fn func()) {
+	ticker := time.NewTicker(d)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				fn()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// runMaintenance prunes the image cache, backs up the database and sends digests on schedule until ctx is done.
+// Each job runs only while queue holds the fetcher lease, so that one of the processes sharing the database runs
+// it. Standby and -no-fetch processes skip them.
+func runMaintenance(ctx context.Context, database *db.DB, queries db.Store, cfg *config.Config, queue *fetchqueue.Queue, imageProxy *imageproxy.Proxy) {
+	leased := func(fn func()) func() {
+		return func() {
+			if queue.SchedulerStatus().Active {
+				fn()
+			}
+		}
+	}
+
+	scheduled(ctx, 24*time.Hour, leased(func() {
+		err := imageProxy.Prune()
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to prune image cache", "error", err)
+		}
+	}))
+	if cfg.BackupDir != "" {
+		scheduled(ctx, cfg.BackupInterval, leased(func() {
+			path, err := backup.Rotate(ctx, database, cfg.BackupDir, int(cfg.BackupKeep))
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to back up database", "error", err)
+				return
+			}
+			slog.InfoContext(ctx, "Backup created", "path", path)
+		}))
+	}
+	if cfg.SMTPEnabled() {
+		sender := mail.NewSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom)
+		scheduled(ctx, 1*time.Hour, leased(func() {
+			err := digest.SendDue(ctx, queries, sender)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to send digests", "error", err)
+			}
+		}))
+	}
+}
@@ -1,17 +1,205 @@
 package cmd
 
 import (
-	"database/sql"
-	"log"
+	"bufio"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
 
 	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/logging"
 )
 
-func RunMigrate(database *sql.DB) {
-	log.Println("Running database migrations...")
+const migrateUsage = `Usage: feedaka [global flags] migrate [command] [flags]
+
+Commands:
+  up [-dry-run]                    Apply pending migrations (default)
+  status [-json]                   List applied and pending migrations with their checksums
+  down [-to <version>] [-dry-run] [-yes]
+                                   Revert migrations down to <version>, by default the last migration
+
+-dry-run prints the SQL that would be run without changing the database. Migrations whose files were edited after
+they were applied block up and down until the files are restored.
+`
+
+// RunMigrate runs the "migrate" subcommands. -migrate runs "migrate up".
+func RunMigrate(database *db.DB, args []string) {
+	command := "up"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+	switch command {
+	case "up":
+		runMigrateUp(database, args)
+	case "status":
+		runMigrateStatus(database, args)
+	case "down":
+		runMigrateDown(database, args)
+	case "help":
+		fmt.Fprint(os.Stdout, migrateUsage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown migrate command: %s\n\n%s", command, migrateUsage)
+		os.Exit(2)
+	}
+}
+
+func runMigrateUp(database *db.DB, args []string) {
+	fs := flag.NewFlagSet("migrate up", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Print the pending migrations instead of applying them")
+	parseArgs(fs, args, migrateUsage, 0)
+
+	if *dryRun {
+		migrations, err := db.PendingMigrations(database)
+		if err != nil {
+			logging.Fatal("Failed to find pending migrations", "error", err)
+		}
+		for _, m := range migrations {
+			printMigrationSQL(m.Filename, m.SQL)
+		}
+		slog.Info("Dry run: no migrations were applied", "pending", len(migrations))
+		return
+	}
+
+	slog.Info("Running database migrations")
 	err := db.RunMigrations(database)
 	if err != nil {
-		log.Fatalf("Migration failed: %v", err)
+		logging.Fatal("Migration failed", "error", err)
+	}
+	slog.Info("Migrations completed")
+}
+
+type migrationJSON struct {
+	Version         int    `json:"version"`
+	Filename        string `json:"filename,omitempty"`
+	Status          string `json:"status"`
+	AppliedAt       string `json:"applied_at,omitempty"`
+	Checksum        string `json:"checksum,omitempty"`
+	AppliedChecksum string `json:"applied_checksum,omitempty"`
+	HasDown         bool   `json:"has_down"`
+}
+
+func migrationState(s db.MigrationStatus) string {
+	switch {
+	case s.Modified():
+		return "modified"
+	case s.Applied && s.Filename == "":
+		// Applied by a newer build
+		return "unknown"
+	case s.Applied:
+		return "applied"
+	default:
+		return "pending"
+	}
+}
+
+func runMigrateStatus(database *db.DB, args []string) {
+	fs := flag.NewFlagSet("migrate status", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print JSON instead of a table")
+	parseArgs(fs, args, migrateUsage, 0)
+
+	statuses, err := db.GetMigrationStatus(database)
+	if err != nil {
+		logging.Fatal("Failed to get migration status", "error", err)
+	}
+
+	if *asJSON {
+		result := make([]migrationJSON, 0, len(statuses))
+		for _, s := range statuses {
+			result = append(result, migrationJSON{
+				Version:         s.Version,
+				Filename:        s.Filename,
+				Status:          migrationState(s),
+				AppliedAt:       s.AppliedAt,
+				Checksum:        s.Checksum,
+				AppliedChecksum: s.AppliedChecksum,
+				HasDown:         s.HasDown,
+			})
+		}
+		printJSON(result)
+		return
+	}
+
+	rows := make([][]string, 0, len(statuses))
+	for _, s := range statuses {
+		checksum := shortChecksum(s.Checksum)
+		if s.Modified() {
+			checksum += " (applied " + shortChecksum(s.AppliedChecksum) + ")"
+		} else if s.Applied && s.AppliedChecksum == "" && s.Filename != "" {
+			checksum += " (not recorded)"
+		}
+		down := "no"
+		if s.HasDown {
+			down = "yes"
+		}
+		rows = append(rows, []string{strconv.Itoa(s.Version), s.Filename, migrationState(s), s.AppliedAt, checksum, down})
+	}
+	printTable([]string{"VERSION", "FILENAME", "STATUS", "APPLIED AT", "CHECKSUM", "DOWN"}, rows)
+}
+
+// shortChecksum abbreviates a checksum for the table, like Git abbreviates commit hashes.
+func shortChecksum(checksum string) string {
+	if len(checksum) > 12 {
+		return checksum[:12]
+	}
+	return checksum
+}
+
+func runMigrateDown(database *db.DB, args []string) {
+	fs := flag.NewFlagSet("migrate down", flag.ExitOnError)
+	to := fs.Int("to", -1, "Schema version to revert to. Defaults to the version before the last migration.")
+	dryRun := fs.Bool("dry-run", false, "Print the down migrations instead of running them")
+	yes := fs.Bool("yes", false, "Do not ask for confirmation. Required if stdin is not a terminal.")
+	parseArgs(fs, args, migrateUsage, 0)
+
+	target := *to
+	if target < 0 {
+		target = previousVersion(database)
+	}
+	migrations, err := db.MigrationsToRollback(database, target)
+	if err != nil {
+		logging.Fatal("Failed to find migrations to revert", "error", err)
+	}
+
+	if *dryRun {
+		for _, m := range migrations {
+			printMigrationSQL(strings.TrimSuffix(m.Filename, ".sql")+".down.sql", m.DownSQL)
+		}
+		slog.Info("Dry run: no migrations were reverted", "to_revert", len(migrations), "target_version", target)
+		return
 	}
-	log.Println("Migrations completed successfully")
+
+	if len(migrations) > 0 && !*yes {
+		question := fmt.Sprintf("Revert %d migrations down to version %d? Data in the dropped tables and columns is lost.", len(migrations), target)
+		if !confirm(bufio.NewReader(os.Stdin), question) {
+			logging.Fatal("Reverting not confirmed. Pass -yes to revert without confirmation.")
+		}
+	}
+	if err := db.RollbackMigrations(database, target); err != nil {
+		logging.Fatal("Reverting migrations failed", "error", err)
+	}
+}
+
+// previousVersion returns the version of the second newest applied migration, or 0 if there is none.
+func previousVersion(database *db.DB) int {
+	statuses, err := db.GetMigrationStatus(database)
+	if err != nil {
+		logging.Fatal("Failed to get migration status", "error", err)
+	}
+	var applied []int
+	for _, s := range statuses {
+		if s.Applied {
+			applied = append(applied, s.Version)
+		}
+	}
+	if len(applied) < 2 {
+		return 0
+	}
+	return applied[len(applied)-2]
+}
+
+func printMigrationSQL(filename, sql string) {
+	fmt.Printf("-- %s\n%s\n", filename, strings.TrimRight(sql, "\n"))
 }
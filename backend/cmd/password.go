@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const minPasswordLength = 15
+
+func stdinIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// readLine reads a line from in without the line break. The last line may lack one.
+func readLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readPassword reads a new password from passwordFile if it is set. Otherwise it prompts for the password on the
+// terminal without echoing it, or reads it from the next line of in if stdin is not a terminal, so that provisioning
+// scripts can pipe it in. Leading and trailing whitespace is removed.
+func readPassword(in *bufio.Reader, passwordFile string) (string, error) {
+	var password string
+	switch {
+	case passwordFile != "":
+		b, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password file: %w", err)
+		}
+		password, _, _ = strings.Cut(string(b), "\n")
+	case stdinIsTerminal():
+		p, err := promptHidden("Enter password: ")
+		if err != nil {
+			return "", err
+		}
+		confirmation, err := promptHidden("Confirm password: ")
+		if err != nil {
+			return "", err
+		}
+		if p != confirmation {
+			return "", errors.New("passwords do not match")
+		}
+		password = p
+	default:
+		p, err := readLine(in)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password: %w", err)
+		}
+		password = p
+	}
+	password = strings.TrimSpace(password)
+
+	if len(password) < minPasswordLength {
+		return "", fmt.Errorf("password must be at least %d characters long", minPasswordLength)
+	}
+	return password, nil
+}
+
+func promptHidden(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return string(b), nil
+}
+
+// confirm asks a yes/no question on the terminal. It returns false if stdin is not a terminal.
+func confirm(in *bufio.Reader, question string) bool {
+	if !stdinIsTerminal() {
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+	answer, err := readLine(in)
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
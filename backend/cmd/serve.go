@@ -2,20 +2,23 @@ package cmd
 
 import (
 	"context"
-	"database/sql"
 	"embed"
-	"log"
+	"errors"
+	"flag"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
-	"github.com/hashicorp/go-multierror"
+	"github.com/gorilla/websocket"
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,86 +26,61 @@ import (
 
 	"undef.ninja/x/feedaka/auth"
 	"undef.ninja/x/feedaka/config"
+	appcontext "undef.ninja/x/feedaka/context"
+	"undef.ninja/x/feedaka/credential"
 	"undef.ninja/x/feedaka/db"
-	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/favicon"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/fever"
 	"undef.ninja/x/feedaka/graphql"
 	"undef.ninja/x/feedaka/graphql/resolver"
+	"undef.ninja/x/feedaka/greader"
+	"undef.ninja/x/feedaka/health"
+	"undef.ninja/x/feedaka/imageproxy"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/metrics"
+	"undef.ninja/x/feedaka/output"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
 )
 
-func fetchOneFeed(feedID int64, url string, ctx context.Context, queries *db.Queries) error {
-	log.Printf("Fetching %s...\n", url)
-	f, err := feed.Fetch(ctx, url)
-	if err != nil {
-		return err
-	}
-	return feed.Sync(ctx, queries, feedID, f)
-}
+const serveUsage = `Usage: feedaka [global flags] serve [-no-fetch]
 
-func listFeedsToBeFetched(ctx context.Context, queries *db.Queries) (map[int64]string, error) {
-	feeds, err := queries.GetFeedsToFetch(ctx)
-	if err != nil {
-		return nil, err
-	}
+Run the server. Feeds are fetched on schedule by one of the serve and worker processes sharing the database at a
+time. With -no-fetch, this server leaves that to the others and only fetches the feeds that its users refresh.
+The process fetching on schedule also prunes the image cache, backs up the database and sends digests.
+`
 
-	result := make(map[int64]string)
-	for _, feed := range feeds {
-		fetchedAtTime, err := time.Parse(time.RFC3339, feed.FetchedAt)
-		if err != nil {
-			log.Fatal(err)
-		}
-		now := time.Now().UTC()
-		if now.Sub(fetchedAtTime).Minutes() <= 10 {
-			continue
-		}
-		result[feed.ID] = feed.Url
-	}
-	return result, nil
-}
+func RunServe(database *db.DB, cfg *config.Config, publicFS embed.FS, args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	noFetch := fs.Bool("no-fetch", false, "Do not fetch feeds on schedule")
+	parseArgs(fs, args, serveUsage, 0)
 
-func fetchAllFeeds(ctx context.Context, queries *db.Queries) error {
-	feeds, err := listFeedsToBeFetched(ctx, queries)
+	err := db.ValidateSchemaVersion(database)
 	if err != nil {
-		return err
-	}
-
-	var result *multierror.Error
-	for feedID, url := range feeds {
-		err := fetchOneFeed(feedID, url, ctx, queries)
-		if err != nil {
-			result = multierror.Append(result, err)
-		}
-		time.Sleep(5 * time.Second)
+		logging.Fatal("Invalid database schema", "error", err)
 	}
-	return result.ErrorOrNil()
-}
 
-func scheduled(ctx context.Context, d time.Duration, fn func()) {
-	ticker := time.NewTicker(d)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				fn()
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
-}
+	queries := db.NewStore(database, metrics.InstrumentDB)
 
-func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
-	err := db.ValidateSchemaVersion(database)
+	credentials, err := credential.NewStore(queries, cfg.CredentialsKey)
 	if err != nil {
-		log.Fatal(err)
+		logging.Fatal("Failed to set up credential store", "error", err)
 	}
 
-	queries := db.New(database)
+	fetcher, err := newFetcher(cfg)
+	if err != nil {
+		logging.Fatal("Failed to set up fetcher", "error", err)
+	}
 
 	sessionConfig := auth.NewSessionConfig(cfg.SessionSecret, cfg.DevNonSecureCookie)
 
 	e := echo.New()
+	// Startup is logged with slog instead
+	e.HideBanner = true
+	e.HidePort = true
 
-	e.Use(middleware.Logger())
+	e.Use(logging.Middleware("/healthz", "/readyz"))
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
 	e.Use(session.Middleware(sessionConfig.GetStore()))
@@ -113,16 +91,97 @@ func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
 		Filesystem: http.FS(publicFS),
 	}))
 
+	bus := pubsub.NewBus()
+
+	// WebSub is enabled only when the server knows its public URL
+	var subscriber *websub.Subscriber
+	if cfg.BaseURL != "" {
+		subscriber = websub.NewSubscriber(queries, bus, cfg.BaseURL, fetcher)
+		e.GET("/websub/callback/:feedId", subscriber.HandleVerify)
+		e.POST("/websub/callback/:feedId", subscriber.HandleNotify)
+	}
+
+	// Fever API for third-party clients. It authenticates with its own API key.
+	feverHandler := fever.NewHandler(queries, bus)
+	e.Match([]string{http.MethodGet, http.MethodPost}, "/fever", feverHandler.Handle)
+	e.Match([]string{http.MethodGet, http.MethodPost}, "/fever/", feverHandler.Handle)
+
+	// Google Reader API for third-party clients. It issues its own auth tokens.
+	greaderHandler := greader.NewHandler(queries, bus, subscriber, fetcher, cfg.SessionSecret)
+	greaderHandler.Register(e)
+
+	// Output feeds are public. Their URLs contain an unguessable token.
+	outputHandler := output.NewHandler(queries, cfg.BaseURL)
+	e.GET("/output/:file", outputHandler.Handle)
+
+	// Images in article content are served through the proxy. Third-party API clients get the original URLs.
+	imageProxy := imageproxy.New(cfg.SessionSecret, cfg.ImageCacheDir, cfg.ImageCacheRetention, fetcher.PublicClient())
+	e.GET("/proxy/image", imageProxy.Handle, auth.SessionAuthMiddleware(sessionConfig, queries))
+
+	// Scheduled and manual refreshes are fetched in the background by the queue
+	fetchQueue := fetchqueue.New(queries, fetcher, credentials, subscriber, bus, fetchqueue.Config{
+		Workers:      int(cfg.FetchWorkers),
+		JobRetention: cfg.FetchJobRetention,
+	})
+
+	e.GET("/metrics", metrics.Handler(cfg.MetricsToken))
+
+	// Probes for container orchestrators and uptime monitors
+	healthHandler := health.NewHandler(database, fetchQueue, 2*cfg.FetchInterval)
+	e.GET("/healthz", healthHandler.Healthz)
+	e.GET("/readyz", healthHandler.Readyz)
+
+	faviconHandler := favicon.NewHandler(queries)
+	e.GET("/icons/:feedId", faviconHandler.Handle, auth.SessionAuthMiddleware(sessionConfig, queries))
+
 	// Setup GraphQL server
 	srv := handler.New(graphql.NewExecutableSchema(graphql.Config{Resolvers: &resolver.Resolver{
-		DB:            database,
 		Queries:       queries,
 		SessionConfig: sessionConfig,
+		WebSub:        subscriber,
+		PubSub:        bus,
+		ImageProxy:    imageProxy,
+		Credentials:   credentials,
+		Fetcher:       fetcher,
+		FetchQueue:    fetchQueue,
 	}}))
 
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				// Session cookies are sent with cross-site WebSocket handshakes, so reject other origins.
+				// The Vite dev server proxies requests from a different origin.
+				return cfg.DevNonSecureCookie || auth.IsSameOrigin(r)
+			},
+		},
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			// The handshake request went through SessionAuthMiddleware
+			if _, ok := appcontext.GetUserID(ctx); !ok {
+				return nil, nil, errors.New("authentication required")
+			}
+			return ctx, &initPayload, nil
+		},
+	})
+
+	srv.AroundOperations(func(ctx context.Context, next gqlgen.OperationHandler) gqlgen.ResponseHandler {
+		op := gqlgen.GetOperationContext(ctx).Operation
+		// Only the operation name is logged. Variables may contain passwords and tokens.
+		if op != nil && op.Name != "" {
+			ctx = logging.With(ctx, "operation", op.Name)
+		}
+
+		// Read-only API tokens may not run mutations
+		if scope, ok := appcontext.GetAPITokenScope(ctx); ok && scope == auth.ScopeReadOnly && op != nil && op.Operation == ast.Mutation {
+			return gqlgen.OneShot(gqlgen.ErrorResponse(ctx, "forbidden: this API token is read-only"))
+		}
+		return next(ctx)
+	})
+
+	srv.Use(metrics.GraphQL{})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
@@ -133,7 +192,7 @@ func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
 
 	// GraphQL endpoints with authentication middleware
 	graphqlGroup := e.Group("/graphql")
-	graphqlGroup.Use(auth.SessionAuthMiddleware(sessionConfig))
+	graphqlGroup.Use(auth.SessionAuthMiddleware(sessionConfig, queries))
 	graphqlGroup.POST("", func(c echo.Context) error {
 		// Add Echo context to GraphQL context
 		ctx := context.WithValue(c.Request().Context(), "echo", c)
@@ -151,12 +210,21 @@ func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	scheduled(ctx, 1*time.Hour, func() {
-		err := fetchAllFeeds(ctx, queries)
-		if err != nil {
-			log.Printf("Failed to fetch feeds: %v\n", err)
-		}
-	})
+	// Waited for on shutdown so that the fetcher lease is released
+	var background sync.WaitGroup
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		fetchQueue.Run(ctx)
+	}()
+	if !*noFetch {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			fetchQueue.RunScheduler(ctx, cfg.FetchInterval)
+		}()
+	}
+	runMaintenance(ctx, database, queries, cfg, fetchQueue, imageProxy)
 
 	// Setup graceful shutdown
 	go func() {
@@ -164,7 +232,7 @@ func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 		<-sigChan
 
-		log.Println("Shutting down server...")
+		slog.Info("Shutting down server")
 		cancel()
 
 		// Give time for graceful shutdown
@@ -172,14 +240,16 @@ func RunServe(database *sql.DB, cfg *config.Config, publicFS embed.FS) {
 		defer shutdownCancel()
 
 		if err := e.Shutdown(shutdownCtx); err != nil {
-			log.Printf("Error during shutdown: %v\n", err)
+			slog.Error("Error during shutdown", "error", err)
 		}
 	}()
 
-	log.Printf("Server starting on port %s...\n", cfg.Port)
-	err = e.Start(":" + cfg.Port)
+	slog.Info("Server starting", "addr", cfg.ListenAddr)
+	err = e.Start(cfg.ListenAddr)
 	if err != nil && err != http.ErrServerClosed {
-		log.Printf("Server error: %v\n", err)
+		slog.Error("Server error", "error", err)
 	}
-	log.Println("Server stopped")
+	cancel()
+	background.Wait()
+	slog.Info("Server stopped")
 }
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"undef.ninja/x/feedaka/auth"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/logging"
+)
+
+const userUsage = `Usage: feedaka [global flags] user <command> [flags] <arguments>
+
+Commands:
+  list [-json]                                    List users
+  create [-password-file <path>] <username>       Create a user
+  reset-password [-password-file <path>] <username>
+                                                  Set a new password
+  rename <username> <new-username>                Change the username. The Fever password has to be set again.
+  disable <username>                              Block logins and stop fetching the user's feeds
+  enable <username>                               Undo disable
+  delete [-yes] <username>                        Delete the user and all their feeds and articles
+
+Passwords are read from -password-file, from the terminal without echo, or from stdin if it is not a terminal.
+`
+
+// RunUser runs the "user" subcommands, which manage users directly in the database.
+func RunUser(database *db.DB, args []string) {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, userUsage)
+		os.Exit(2)
+	}
+
+	if err := db.ValidateSchemaVersion(database); err != nil {
+		logging.Fatal("Invalid database schema", "error", err)
+	}
+
+	queries := db.NewStore(database, nil)
+	ctx := context.Background()
+	command, args := args[0], args[1:]
+	switch command {
+	case "list":
+		runUserList(ctx, queries, args)
+	case "create":
+		runUserCreate(ctx, queries, args)
+	case "reset-password":
+		runUserResetPassword(ctx, queries, args)
+	case "rename":
+		runUserRename(ctx, queries, args)
+	case "disable":
+		runUserSetDisabled(ctx, queries, args, true)
+	case "enable":
+		runUserSetDisabled(ctx, queries, args, false)
+	case "delete":
+		runUserDelete(ctx, queries, args)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(os.Stdout, userUsage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown user command: %s\n\n%s", command, userUsage)
+		os.Exit(2)
+	}
+}
+
+type userJSON struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	CreatedAt string `json:"created_at"`
+	Disabled  bool   `json:"disabled"`
+}
+
+func runUserList(ctx context.Context, queries db.Store, args []string) {
+	fs := flag.NewFlagSet("user list", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print JSON instead of a table")
+	parseArgs(fs, args, userUsage, 0)
+
+	users, err := queries.GetUsers(ctx)
+	if err != nil {
+		logging.Fatal("Failed to query users", "error", err)
+	}
+
+	if *asJSON {
+		result := make([]userJSON, 0, len(users))
+		for _, u := range users {
+			result = append(result, userJSON{
+				ID:        u.ID,
+				Username:  u.Username,
+				CreatedAt: u.CreatedAt,
+				Disabled:  u.Disabled != 0,
+			})
+		}
+		printJSON(result)
+		return
+	}
+
+	rows := make([][]string, 0, len(users))
+	for _, u := range users {
+		status := "active"
+		if u.Disabled != 0 {
+			status = "disabled"
+		}
+		rows = append(rows, []string{strconv.FormatInt(u.ID, 10), u.Username, u.CreatedAt, status})
+	}
+	printTable([]string{"ID", "USERNAME", "CREATED AT", "STATUS"}, rows)
+}
+
+func runUserCreate(ctx context.Context, queries db.Store, args []string) {
+	fs := flag.NewFlagSet("user create", flag.ExitOnError)
+	passwordFile := fs.String("password-file", "", "Read the password from the first line of this file")
+	username := parseArgs(fs, args, userUsage, 1)[0]
+
+	password, err := readPassword(bufio.NewReader(os.Stdin), *passwordFile)
+	if err != nil {
+		logging.Fatal("Failed to read password", "error", err)
+	}
+	user, err := createUser(ctx, queries, username, password)
+	if err != nil {
+		logging.Fatal("Failed to create user", "error", err)
+	}
+	slog.Info("User created", "user_id", user.ID, "username", user.Username)
+}
+
+func runUserResetPassword(ctx context.Context, queries db.Store, args []string) {
+	fs := flag.NewFlagSet("user reset-password", flag.ExitOnError)
+	passwordFile := fs.String("password-file", "", "Read the password from the first line of this file")
+	username := parseArgs(fs, args, userUsage, 1)[0]
+
+	user := lookupUser(ctx, queries, username)
+	password, err := readPassword(bufio.NewReader(os.Stdin), *passwordFile)
+	if err != nil {
+		logging.Fatal("Failed to read password", "error", err)
+	}
+	hashedPassword, err := auth.HashPassword(password)
+	if err != nil {
+		logging.Fatal("Failed to hash password", "error", err)
+	}
+
+	// Google Reader API tokens are signed with the password hash, so they are revoked as well
+	err = queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		PasswordHash: hashedPassword,
+		ID:           user.ID,
+	})
+	if err != nil {
+		logging.Fatal("Failed to update password", "error", err)
+	}
+	slog.Info("Password updated", "user_id", user.ID, "username", user.Username)
+}
+
+func runUserRename(ctx context.Context, queries db.Store, args []string) {
+	fs := flag.NewFlagSet("user rename", flag.ExitOnError)
+	names := parseArgs(fs, args, userUsage, 2)
+
+	user := lookupUser(ctx, queries, names[0])
+	newUsername := strings.TrimSpace(names[1])
+	if newUsername == "" {
+		logging.Fatal("Username cannot be empty")
+	}
+	if err := checkUsernameAvailable(ctx, queries, newUsername); err != nil {
+		logging.Fatal("Failed to rename user", "error", err)
+	}
+
+	err := queries.UpdateUsername(ctx, db.UpdateUsernameParams{
+		Username: newUsername,
+		ID:       user.ID,
+	})
+	if err != nil {
+		logging.Fatal("Failed to rename user", "error", err)
+	}
+	slog.Info("User renamed", "user_id", user.ID, "old_username", user.Username, "username", newUsername)
+	slog.Warn("The Fever API key is derived from the username and was cleared. Set the Fever password again to keep using Fever clients.", "username", newUsername)
+}
+
+func runUserSetDisabled(ctx context.Context, queries db.Store, args []string, disabled bool) {
+	name := "user enable"
+	var value int64
+	if disabled {
+		name = "user disable"
+		value = 1
+	}
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	username := parseArgs(fs, args, userUsage, 1)[0]
+
+	user := lookupUser(ctx, queries, username)
+	err := queries.UpdateUserDisabled(ctx, db.UpdateUserDisabledParams{
+		Disabled: value,
+		ID:       user.ID,
+	})
+	if err != nil {
+		logging.Fatal("Failed to update user", "error", err)
+	}
+	if disabled {
+		slog.Info("User disabled", "user_id", user.ID, "username", user.Username)
+	} else {
+		slog.Info("User enabled", "user_id", user.ID, "username", user.Username)
+	}
+}
+
+func runUserDelete(ctx context.Context, queries db.Store, args []string) {
+	fs := flag.NewFlagSet("user delete", flag.ExitOnError)
+	yes := fs.Bool("yes", false, "Do not ask for confirmation. Required if stdin is not a terminal.")
+	username := parseArgs(fs, args, userUsage, 1)[0]
+
+	user := lookupUser(ctx, queries, username)
+	if !*yes && !confirm(bufio.NewReader(os.Stdin), fmt.Sprintf("Delete user %s and all their feeds and articles?", user.Username)) {
+		logging.Fatal("Deletion not confirmed. Pass -yes to delete without confirmation.")
+	}
+
+	if err := deleteUser(ctx, queries, user.ID); err != nil {
+		logging.Fatal("Failed to delete user", "error", err)
+	}
+	slog.Info("User deleted", "user_id", user.ID, "username", user.Username)
+}
+
+// deleteUser deletes the user and everything that belongs to them. Rows are deleted explicitly because SQLite does
+// not enforce the ON DELETE CASCADE of the foreign keys unless it is enabled per connection.
+func deleteUser(ctx context.Context, queries db.Store, userID int64) error {
+	return queries.InTx(ctx, func(qtx db.Store) error {
+		deletes := []func(context.Context, int64) error{
+			qtx.DeleteArticlesByUser,
+			qtx.DeleteWebSubSubscriptionsByUser,
+			qtx.DeleteFeedIconsByUser,
+			qtx.DeleteFeedScrapersByUser,
+			qtx.DeleteFeedCredentialsByUser,
+			qtx.DeleteDigestFeeds,
+			qtx.DeleteDigestFolders,
+			qtx.DeleteDigestSettings,
+			qtx.DeleteOutputFeedsByUser,
+			qtx.DeleteAPITokensByUser,
+			qtx.DeleteFoldersByUser,
+			qtx.DeleteFeedsByUser,
+			qtx.DeleteUser,
+		}
+		for _, del := range deletes {
+			if err := del(ctx, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
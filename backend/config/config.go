@@ -2,34 +2,428 @@ package config
 
 import (
 	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"undef.ninja/x/feedaka/db"
 )
 
+// Used in the User-Agent header when base_url is not set
+const defaultContactURL = "https://github.com/nsfisis/feedaka"
+
 var (
-	ErrNoSessionSecretEnvVar = errors.New("FEEDAKA_SESSION_SECRET environment variable is not set")
+	ErrNoSessionSecretEnvVar = errors.New("session_secret is not set (set FEEDAKA_SESSION_SECRET or session_secret in the config file)")
 )
 
+// Config is the effective configuration. Each field is read from, in order of precedence, a command line flag,
+// an environment variable, the config file and the default. The names are derived from the `config` tag:
+// "fetch.max_size" is the key max_size in the fetch section of the file, FEEDAKA_FETCH_MAX_SIZE and -fetch-max-size.
+// Empty values count as unset.
 type Config struct {
-	Port               string
-	SessionSecret      string
-	DevNonSecureCookie bool
+	ListenAddr         string `config:"listen_addr" default:":8080" usage:"Address the server listens on"`
+	DatabasePath       string `config:"database_path" default:"data/feedaka.db" usage:"Path of the SQLite database"`
+	DatabaseURL        string `config:"database_url" secret:"url" usage:"PostgreSQL URL (postgres://...). If set, it is used instead of database_path."`
+	BaseURL            string `config:"base_url" usage:"Public URL of this server. Required for WebSub."`
+	SessionSecret      string `config:"session_secret" secret:"true" usage:"Secret signing session cookies (required)"`
+	DevNonSecureCookie bool   `config:"dev_non_secure_cookie" usage:"Send cookies over plain HTTP, for development"`
+	CredentialsKey     string `config:"credentials_key" secret:"true" usage:"Secret encrypting feed credentials. Defaults to session_secret."`
+	MetricsToken       string `config:"metrics_token" secret:"true" usage:"Bearer token required to read /metrics"`
+
+	ImageCacheDir       string        `config:"image_cache.dir" default:"data/image-cache" usage:"Directory caching proxied images"`
+	ImageCacheRetention time.Duration `config:"image_cache.retention" default:"720h" usage:"How long proxied images are cached"`
+
+	// Settings of the scheduler and the HTTP client that fetches feeds
+	FetchInterval       time.Duration `config:"fetch.interval" default:"1h" usage:"Interval at which feeds due to be fetched are queued"`
+	FetchWorkers        int64         `config:"fetch.workers" default:"4" usage:"Number of refresh jobs processed concurrently"`
+	FetchJobRetention   time.Duration `config:"fetch.job_retention" default:"1h" usage:"How long finished refresh jobs can be polled"`
+	FetchConnectTimeout time.Duration `config:"fetch.connect_timeout" default:"10s" usage:"Timeout of connecting to a site"`
+	FetchTimeout        time.Duration `config:"fetch.timeout" default:"30s" usage:"Timeout of a whole request. Feeds may override it."`
+	FetchMaxSize        int64         `config:"fetch.max_size" default:"10485760" usage:"Maximum size of a response in bytes"`
+	FetchUserAgent      string        `config:"fetch.user_agent" usage:"User-Agent header. Defaults to \"feedaka (+<base_url>)\"."`
+	FetchProxy          string        `config:"fetch.proxy" secret:"url" usage:"URL of an HTTP, HTTPS or SOCKS5 proxy. Defaults to HTTP_PROXY/HTTPS_PROXY. Proxied images are fetched directly."`
+
+	// Scheduled backups of the SQLite database made by the server. They are disabled if the directory is empty.
+	BackupDir      string        `config:"backup.dir" usage:"Directory the server writes scheduled backups to. Empty disables them."`
+	BackupInterval time.Duration `config:"backup.interval" default:"24h" usage:"Interval between scheduled backups"`
+	BackupKeep     int64         `config:"backup.keep" default:"7" usage:"Number of scheduled backups kept. Older ones are deleted."`
+
+	// SMTP server used to send digest emails. Digests are disabled if the host is empty.
+	SMTPHost     string `config:"smtp.host" usage:"SMTP server host"`
+	SMTPPort     string `config:"smtp.port" default:"587" usage:"SMTP server port"`
+	SMTPUsername string `config:"smtp.username" usage:"SMTP username"`
+	SMTPPassword string `config:"smtp.password" secret:"true" usage:"SMTP password"`
+	SMTPFrom     string `config:"smtp.from" usage:"Sender address of digests. Defaults to the SMTP username."`
+
+	LogFormat string `config:"log.format" default:"text" usage:"Log output: text or json"`
+	LogLevel  string `config:"log.level" default:"info" usage:"Minimum log level: debug, info, warn or error"`
+}
+
+// field is a configurable field of Config.
+type field struct {
+	index  int
+	key    string
+	def    string
+	usage  string
+	secret string
+}
+
+func (f field) env() string {
+	return "FEEDAKA_" + strings.ToUpper(strings.ReplaceAll(f.key, ".", "_"))
+}
+
+func (f field) flag() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(f.key)
+}
+
+var fields = func() []field {
+	var result []field
+	t := reflect.TypeOf(Config{})
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		result = append(result, field{
+			index:  i,
+			key:    sf.Tag.Get("config"),
+			def:    sf.Tag.Get("default"),
+			usage:  sf.Tag.Get("usage"),
+			secret: sf.Tag.Get("secret"),
+		})
+	}
+	return result
+}()
+
+// Flags are the command line flags overriding the configuration.
+type Flags struct {
+	fs         *flag.FlagSet
+	configPath *string
+	values     map[string]*flagValue
+}
+
+// RegisterFlags adds -config and a flag for each field to fs. Call Load after fs is parsed.
+func RegisterFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{
+		fs:         fs,
+		configPath: fs.String("config", "", "Path of the YAML config file. Defaults to FEEDAKA_CONFIG."),
+		values:     make(map[string]*flagValue),
+	}
+	for _, fld := range fields {
+		v := &flagValue{isBool: reflect.TypeOf(Config{}).Field(fld.index).Type.Kind() == reflect.Bool}
+		fs.Var(v, fld.flag(), fld.usage)
+		f.values[fld.key] = v
+	}
+	return f
+}
+
+// flagValue records whether a flag was given, so that unset flags do not override other sources.
+type flagValue struct {
+	value  string
+	isSet  bool
+	isBool bool
+}
+
+func (v *flagValue) String() string {
+	return v.value
+}
+
+func (v *flagValue) Set(s string) error {
+	v.value = s
+	v.isSet = true
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool {
+	return v.isBool
+}
+
+// Load reads the configuration from the defaults, the config file, the environment and the flags.
+func (f *Flags) Load() (*Config, error) {
+	values := make(map[string]string)
+	for _, fld := range fields {
+		if fld.def != "" {
+			values[fld.key] = fld.def
+		}
+	}
+
+	path := *f.configPath
+	if path == "" {
+		path = os.Getenv("FEEDAKA_CONFIG")
+	}
+	if path != "" {
+		fileValues, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fileValues {
+			if v != "" {
+				values[k] = v
+			}
+		}
+	}
+
+	// FEEDAKA_PORT predates FEEDAKA_LISTEN_ADDR
+	if port := os.Getenv("FEEDAKA_PORT"); port != "" {
+		values["listen_addr"] = ":" + port
+	}
+	for _, fld := range fields {
+		if v := os.Getenv(fld.env()); v != "" {
+			values[fld.key] = v
+		}
+	}
+
+	for key, v := range f.values {
+		if v.isSet {
+			values[key] = v.value
+		}
+	}
+
+	return build(values)
 }
 
-func LoadConfig() (*Config, error) {
-	port := os.Getenv("FEEDAKA_PORT")
-	sessionSecret := os.Getenv("FEEDAKA_SESSION_SECRET")
-	devNonSecureCookie := os.Getenv("FEEDAKA_DEV_NON_SECURE_COOKIE")
+// readFile reads the YAML config file into a map from field keys to values.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	known := make(map[string]bool, len(fields))
+	for _, fld := range fields {
+		known[fld.key] = true
+	}
+	values := make(map[string]string)
+	var walk func(prefix string, m map[string]any) error
+	walk = func(prefix string, m map[string]any) error {
+		for k, v := range m {
+			key := prefix + k
+			if section, ok := v.(map[string]any); ok {
+				if err := walk(key+".", section); err != nil {
+					return err
+				}
+				continue
+			}
+			if !known[key] {
+				return fmt.Errorf("config file %s: unknown key %q", path, key)
+			}
+			switch v := v.(type) {
+			case nil:
+			case string, bool, int, float64:
+				values[key] = fmt.Sprint(v)
+			default:
+				return fmt.Errorf("config file %s: %s must be a scalar value", path, key)
+			}
+		}
+		return nil
+	}
+	if err := walk("", doc); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// build parses the values into a Config, fills in derived defaults and validates it.
+func build(values map[string]string) (*Config, error) {
+	cfg := &Config{}
+	rv := reflect.ValueOf(cfg).Elem()
+	var errs []error
+	for _, fld := range fields {
+		s, ok := values[fld.key]
+		if !ok {
+			continue
+		}
+		if err := setField(rv.Field(fld.index), s); err != nil {
+			errs = append(errs, fmt.Errorf("%s (%s): %w", fld.key, fld.env(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	if cfg.CredentialsKey == "" {
+		cfg.CredentialsKey = cfg.SessionSecret
+	}
+	if cfg.SMTPFrom == "" {
+		cfg.SMTPFrom = cfg.SMTPUsername
+	}
+	if cfg.FetchUserAgent == "" {
+		// Site owners can find out who is fetching their feeds
+		contactURL := cfg.BaseURL
+		if contactURL == "" {
+			contactURL = defaultContactURL
+		}
+		cfg.FetchUserAgent = "feedaka (+" + contactURL + ")"
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func setField(v reflect.Value, s string) error {
+	switch v.Interface().(type) {
+	case string:
+		v.SetString(s)
+	case bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("must be true or false: %q", s)
+		}
+		v.SetBool(b)
+	case int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("must be an integer: %q", s)
+		}
+		v.SetInt(n)
+	case time.Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("must be a duration such as \"30s\": %q", s)
+		}
+		v.SetInt(int64(d))
+	default:
+		panic("unsupported config field type: " + v.Type().String())
+	}
+	return nil
+}
 
-	if port == "" {
-		port = "8080"
+func (c *Config) validate() error {
+	var errs []error
+	if c.SessionSecret == "" {
+		errs = append(errs, ErrNoSessionSecretEnvVar)
 	}
-	if sessionSecret == "" {
-		return nil, ErrNoSessionSecretEnvVar
+	positive := []struct {
+		key   string
+		value int64
+	}{
+		{"image_cache.retention", int64(c.ImageCacheRetention)},
+		{"fetch.interval", int64(c.FetchInterval)},
+		{"fetch.workers", c.FetchWorkers},
+		{"fetch.job_retention", int64(c.FetchJobRetention)},
+		{"fetch.connect_timeout", int64(c.FetchConnectTimeout)},
+		{"fetch.timeout", int64(c.FetchTimeout)},
+		{"fetch.max_size", c.FetchMaxSize},
+		{"backup.interval", int64(c.BackupInterval)},
+		{"backup.keep", c.BackupKeep},
 	}
+	for _, p := range positive {
+		if p.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive", p.key))
+		}
+	}
+	if c.BaseURL != "" {
+		if u, err := url.Parse(c.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("base_url must be an http:// or https:// URL: %q", c.BaseURL))
+		}
+	}
+	if c.DatabaseURL != "" && !db.IsPostgresDSN(c.DatabaseURL) {
+		errs = append(errs, fmt.Errorf("database_url must be a postgres:// or postgresql:// URL"))
+	}
+	if c.BackupDir != "" && c.DatabaseURL != "" {
+		errs = append(errs, fmt.Errorf("backup.dir is supported for SQLite only; back up PostgreSQL with pg_dump"))
+	}
+	if c.LogFormat != "text" && c.LogFormat != "json" {
+		errs = append(errs, fmt.Errorf("log.format must be text or json: %q", c.LogFormat))
+	}
+	switch strings.ToLower(c.LogLevel) {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level must be debug, info, warn or error: %q", c.LogLevel))
+	}
+	return errors.Join(errs...)
+}
+
+// Print writes the configuration as a YAML config file. Secrets are redacted.
+func (c *Config) Print(w io.Writer) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	sections := make(map[string]*yaml.Node)
+	rv := reflect.ValueOf(c).Elem()
+	for _, fld := range fields {
+		parent := root
+		name := fld.key
+		if section, rest, ok := strings.Cut(fld.key, "."); ok {
+			if sections[section] == nil {
+				sections[section] = &yaml.Node{Kind: yaml.MappingNode}
+				root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: section}, sections[section])
+			}
+			parent = sections[section]
+			name = rest
+		}
+
+		value := &yaml.Node{Kind: yaml.ScalarNode, LineComment: fld.usage}
+		switch v := rv.Field(fld.index).Interface().(type) {
+		case string:
+			value.Tag = "!!str"
+			value.Value = redact(v, fld.secret)
+		case time.Duration:
+			value.Tag = "!!str"
+			value.Value = v.String()
+		default:
+			value.Value = fmt.Sprint(v)
+		}
+		parent.Content = append(parent.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, value)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func redact(value, secret string) string {
+	if value == "" {
+		return value
+	}
+	switch secret {
+	case "true":
+		return "[redacted]"
+	case "url":
+		// A value that is not an absolute URL may hold a password anywhere, so it is redacted as a whole
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" {
+			return "[redacted]"
+		}
+		// PostgreSQL also takes passwords as query parameters
+		q := u.Query()
+		redacted := false
+		for _, name := range []string{"password", "sslpassword"} {
+			if q.Has(name) {
+				q.Set(name, "xxxxx")
+				redacted = true
+			}
+		}
+		if redacted {
+			u.RawQuery = q.Encode()
+		}
+		return u.Redacted()
+	}
+	return value
+}
+
+// DatabaseDSN returns the DSN of the database to open with db.Open.
+func (c *Config) DatabaseDSN() string {
+	if c.DatabaseURL != "" {
+		return c.DatabaseURL
+	}
+	return c.DatabasePath
+}
 
-	return &Config{
-		Port:               port,
-		SessionSecret:      sessionSecret,
-		DevNonSecureCookie: devNonSecureCookie == "1",
-	}, nil
+// SMTPEnabled reports whether outgoing mail is configured.
+func (c *Config) SMTPEnabled() bool {
+	return c.SMTPHost != ""
 }
@@ -0,0 +1,19 @@
+package context
+
+import (
+	"context"
+)
+
+const apiTokenScopeContextKey contextKey = "api_token_scope"
+
+// SetAPITokenScope marks the request as authenticated by an API token with the given scope
+func SetAPITokenScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, apiTokenScopeContextKey, scope)
+}
+
+// GetAPITokenScope retrieves the scope of the API token the request is authenticated by.
+// It returns false if the request is authenticated by a session.
+func GetAPITokenScope(ctx context.Context) (string, bool) {
+	scope, ok := ctx.Value(apiTokenScopeContextKey).(string)
+	return scope, ok
+}
@@ -0,0 +1,193 @@
+package credential
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/net/http/httpguts"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+var ErrDecrypt = errors.New("failed to decrypt feed credentials")
+
+// Credentials are sent with every request for a private feed. They are stored encrypted and never returned by the API.
+type Credentials struct {
+	Username    string            `json:"username,omitempty"`
+	Password    string            `json:"password,omitempty"`
+	BearerToken string            `json:"bearer_token,omitempty"`
+	Cookie      string            `json:"cookie,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+}
+
+// IsEmpty reports whether c has nothing to send.
+func (c *Credentials) IsEmpty() bool {
+	return c == nil || (c.Username == "" && c.Password == "" && c.BearerToken == "" && c.Cookie == "" && len(c.Headers) == 0)
+}
+
+// Validate reports whether the custom headers are valid HTTP headers.
+func (c *Credentials) Validate() error {
+	for name, value := range c.Headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return fmt.Errorf("invalid header name: %q", name)
+		}
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return fmt.Errorf("invalid value of header %s", name)
+		}
+	}
+	return nil
+}
+
+// Apply adds the credentials to req. A nil *Credentials adds nothing.
+func (c *Credentials) Apply(req *http.Request) {
+	if c == nil {
+		return
+	}
+	if c.Username != "" || c.Password != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+	if c.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.BearerToken)
+	}
+	if c.Cookie != "" {
+		req.Header.Set("Cookie", c.Cookie)
+	}
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+}
+
+// Remove deletes the headers set by Apply from req. net/http copies headers on redirects and only drops
+// Authorization and Cookie when the host name changes, keeping them on another port and keeping custom headers.
+func (c *Credentials) Remove(req *http.Request) {
+	if c == nil {
+		return
+	}
+	if c.Username != "" || c.Password != "" || c.BearerToken != "" {
+		req.Header.Del("Authorization")
+	}
+	if c.Cookie != "" {
+		req.Header.Del("Cookie")
+	}
+	for name := range c.Headers {
+		req.Header.Del(name)
+	}
+}
+
+// Store saves and loads the credentials of feeds, encrypted with AES-256-GCM.
+type Store struct {
+	queries db.Store
+	aead    cipher.AEAD
+}
+
+// NewStore creates a store whose encryption key is derived from secret.
+func NewStore(queries db.Store, secret string) (*Store, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(secret), nil, []byte("feedaka feed credentials")), key); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		queries: queries,
+		aead:    aead,
+	}, nil
+}
+
+// With returns a store that saves and loads with queries, e.g. a db.Store running in a transaction.
+func (s *Store) With(queries db.Store) *Store {
+	return &Store{
+		queries: queries,
+		aead:    s.aead,
+	}
+}
+
+// Get returns the credentials of the feed, or nil if it has none.
+func (s *Store) Get(ctx context.Context, feedID int64) (*Credentials, error) {
+	data, err := s.queries.GetFeedCredentials(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query feed credentials: %w", err)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+	// The feed ID is authenticated, so credentials cannot be moved to another feed
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, additionalData(feedID))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	var c Credentials
+	if err := json.Unmarshal(plaintext, &c); err != nil {
+		return nil, ErrDecrypt
+	}
+	return &c, nil
+}
+
+// Set replaces the credentials of the feed. Empty credentials are deleted.
+func (s *Store) Set(ctx context.Context, feedID int64, c *Credentials) error {
+	if c.IsEmpty() {
+		if err := s.queries.DeleteFeedCredentials(ctx, feedID); err != nil {
+			return fmt.Errorf("failed to delete feed credentials: %w", err)
+		}
+		return nil
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	sealed := s.aead.Seal(nonce, nonce, plaintext, additionalData(feedID))
+
+	err = s.queries.UpsertFeedCredentials(ctx, db.UpsertFeedCredentialsParams{
+		FeedID: feedID,
+		Data:   base64.StdEncoding.EncodeToString(sealed),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store feed credentials: %w", err)
+	}
+	return nil
+}
+
+// Exists reports whether the feed has credentials.
+func (s *Store) Exists(ctx context.Context, feedID int64) (bool, error) {
+	exists, err := s.queries.CheckFeedCredentialsExist(ctx, feedID)
+	if err != nil {
+		return false, fmt.Errorf("failed to query feed credentials: %w", err)
+	}
+	return exists == 1, nil
+}
+
+func additionalData(feedID int64) []byte {
+	return fmt.Appendf(nil, "feed:%d", feedID)
+}
@@ -0,0 +1,156 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: api_tokens.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createAPIToken = `-- name: CreateAPIToken :one
+INSERT INTO api_tokens (user_id, name, token_hash, scope, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, user_id, name, token_hash, scope, expires_at, last_used_at, created_at
+`
+
+type CreateAPITokenParams struct {
+	UserID    int64
+	Name      string
+	TokenHash string
+	Scope     string
+	ExpiresAt sql.NullString
+	CreatedAt string
+}
+
+func (q *Queries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, createAPIToken,
+		arg.UserID,
+		arg.Name,
+		arg.TokenHash,
+		arg.Scope,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scope,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAPIToken = `-- name: DeleteAPIToken :execrows
+DELETE FROM api_tokens
+WHERE id = ? AND user_id = ?
+`
+
+type DeleteAPITokenParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteAPIToken(ctx context.Context, arg DeleteAPITokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAPIToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteAPITokensByUser = `-- name: DeleteAPITokensByUser :exec
+DELETE FROM api_tokens
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteAPITokensByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAPITokensByUser, userID)
+	return err
+}
+
+const getAPITokenByHash = `-- name: GetAPITokenByHash :one
+SELECT t.id, t.user_id, t.name, t.token_hash, t.scope, t.expires_at, t.last_used_at, t.created_at
+FROM api_tokens AS t
+INNER JOIN users AS u ON t.user_id = u.id
+WHERE t.token_hash = ? AND u.disabled = 0
+`
+
+func (q *Queries) GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, getAPITokenByHash, tokenHash)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scope,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPITokens = `-- name: GetAPITokens :many
+SELECT id, user_id, name, token_hash, scope, expires_at, last_used_at, created_at
+FROM api_tokens
+WHERE user_id = ?
+ORDER BY id
+`
+
+func (q *Queries) GetAPITokens(ctx context.Context, userID int64) ([]ApiToken, error) {
+	rows, err := q.db.QueryContext(ctx, getAPITokens, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiToken{}
+	for rows.Next() {
+		var i ApiToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.TokenHash,
+			&i.Scope,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAPITokenLastUsed = `-- name: UpdateAPITokenLastUsed :exec
+UPDATE api_tokens
+SET last_used_at = ?1
+WHERE id = ?2 AND (last_used_at IS NULL OR last_used_at < ?3)
+`
+
+type UpdateAPITokenLastUsedParams struct {
+	LastUsedAt sql.NullString
+	ID         int64
+	Threshold  sql.NullString
+}
+
+func (q *Queries) UpdateAPITokenLastUsed(ctx context.Context, arg UpdateAPITokenLastUsedParams) error {
+	_, err := q.db.ExecContext(ctx, updateAPITokenLastUsed, arg.LastUsedAt, arg.ID, arg.Threshold)
+	return err
+}
@@ -7,13 +7,15 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 )
 
 const checkArticleExists = `-- name: CheckArticleExists :one
-SELECT EXISTS(
+SELECT CAST(EXISTS(
     SELECT 1 FROM articles
     WHERE feed_id = ? AND guid = ?
-) as article_exists
+) AS INTEGER) as article_exists
 `
 
 type CheckArticleExistsParams struct {
@@ -29,10 +31,10 @@ func (q *Queries) CheckArticleExists(ctx context.Context, arg CheckArticleExists
 }
 
 const checkArticleExistsByGUID = `-- name: CheckArticleExistsByGUID :one
-SELECT EXISTS(
+SELECT CAST(EXISTS(
     SELECT 1 FROM articles
     WHERE guid = ?
-) as article_exists
+) AS INTEGER) as article_exists
 `
 
 func (q *Queries) CheckArticleExistsByGUID(ctx context.Context, guid string) (int64, error) {
@@ -42,18 +44,48 @@ func (q *Queries) CheckArticleExistsByGUID(ctx context.Context, guid string) (in
 	return article_exists, err
 }
 
+const countArticles = `-- name: CountArticles :one
+SELECT COUNT(*)
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?
+`
+
+func (q *Queries) CountArticles(ctx context.Context, userID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countArticles, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadArticles = `-- name: CountUnreadArticles :one
+SELECT COUNT(*)
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
+`
+
+func (q *Queries) CountUnreadArticles(ctx context.Context, userID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadArticles, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createArticle = `-- name: CreateArticle :one
-INSERT INTO articles (feed_id, guid, title, url, is_read)
-VALUES (?, ?, ?, ?, ?)
-RETURNING id, feed_id, guid, title, url, is_read
+INSERT INTO articles (feed_id, guid, title, url, is_read, published_at, content)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id, feed_id, guid, title, url, is_read, is_starred, published_at, content
 `
 
 type CreateArticleParams struct {
-	FeedID int64
-	Guid   string
-	Title  string
-	Url    string
-	IsRead int64
+	FeedID      int64
+	Guid        string
+	Title       string
+	Url         string
+	IsRead      int64
+	PublishedAt string
+	Content     string
 }
 
 func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (Article, error) {
@@ -63,6 +95,8 @@ func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (A
 		arg.Title,
 		arg.Url,
 		arg.IsRead,
+		arg.PublishedAt,
+		arg.Content,
 	)
 	var i Article
 	err := row.Scan(
@@ -72,6 +106,9 @@ func (q *Queries) CreateArticle(ctx context.Context, arg CreateArticleParams) (A
 		&i.Title,
 		&i.Url,
 		&i.IsRead,
+		&i.IsStarred,
+		&i.PublishedAt,
+		&i.Content,
 	)
 	return i, err
 }
@@ -86,26 +123,43 @@ func (q *Queries) DeleteArticlesByFeed(ctx context.Context, feedID int64) error
 	return err
 }
 
+const deleteArticlesByUser = `-- name: DeleteArticlesByUser :exec
+DELETE FROM articles
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteArticlesByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteArticlesByUser, userID)
+	return err
+}
+
 const getArticle = `-- name: GetArticle :one
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.id = ?
 `
 
 type GetArticleRow struct {
-	ID               int64
-	FeedID           int64
-	Guid             string
-	Title            string
-	Url              string
-	IsRead           int64
-	FeedID2          int64
-	FeedUrl          string
-	FeedTitle        string
-	FeedIsSubscribed int64
+	ID                      int64
+	FeedID                  int64
+	Guid                    string
+	Title                   string
+	Url                     string
+	IsRead                  int64
+	FeedID2                 int64
+	FeedUrl                 string
+	FeedTitle               string
+	FeedIsSubscribed        int64
+	FeedFetchFullContent    int64
+	FeedFetchTimeoutSeconds int64
+	FeedFolderID            sql.NullInt64
 }
 
 func (q *Queries) GetArticle(ctx context.Context, id int64) (GetArticleRow, error) {
@@ -122,10 +176,26 @@ func (q *Queries) GetArticle(ctx context.Context, id int64) (GetArticleRow, erro
 		&i.FeedUrl,
 		&i.FeedTitle,
 		&i.FeedIsSubscribed,
+		&i.FeedFetchFullContent,
+		&i.FeedFetchTimeoutSeconds,
+		&i.FeedFolderID,
 	)
 	return i, err
 }
 
+const getArticleContent = `-- name: GetArticleContent :one
+SELECT content
+FROM articles
+WHERE id = ?
+`
+
+func (q *Queries) GetArticleContent(ctx context.Context, id int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getArticleContent, id)
+	var content string
+	err := row.Scan(&content)
+	return content, err
+}
+
 const getArticleGUIDsByFeed = `-- name: GetArticleGUIDsByFeed :many
 SELECT guid
 FROM articles
@@ -155,6 +225,102 @@ func (q *Queries) GetArticleGUIDsByFeed(ctx context.Context, feedID int64) ([]st
 	return items, nil
 }
 
+const getArticlesAfterID = `-- name: GetArticlesAfterID :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?1 AND a.id > ?2
+ORDER BY a.id
+LIMIT ?3
+`
+
+type GetArticlesAfterIDParams struct {
+	UserID  int64
+	AfterID int64
+	Limit   int64
+}
+
+func (q *Queries) GetArticlesAfterID(ctx context.Context, arg GetArticlesAfterIDParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, getArticlesAfterID, arg.UserID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Article{}
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArticlesBeforeID = `-- name: GetArticlesBeforeID :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?1 AND a.id < ?2
+ORDER BY a.id DESC
+LIMIT ?3
+`
+
+type GetArticlesBeforeIDParams struct {
+	UserID   int64
+	BeforeID int64
+	Limit    int64
+}
+
+func (q *Queries) GetArticlesBeforeID(ctx context.Context, arg GetArticlesBeforeIDParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, getArticlesBeforeID, arg.UserID, arg.BeforeID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Article{}
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getArticlesByFeed = `-- name: GetArticlesByFeed :many
 SELECT id, feed_id, guid, title, url, is_read
 FROM articles
@@ -162,12 +328,75 @@ WHERE feed_id = ?
 ORDER BY id DESC
 `
 
-func (q *Queries) GetArticlesByFeed(ctx context.Context, feedID int64) ([]Article, error) {
+type GetArticlesByFeedRow struct {
+	ID     int64
+	FeedID int64
+	Guid   string
+	Title  string
+	Url    string
+	IsRead int64
+}
+
+func (q *Queries) GetArticlesByFeed(ctx context.Context, feedID int64) ([]GetArticlesByFeedRow, error) {
 	rows, err := q.db.QueryContext(ctx, getArticlesByFeed, feedID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
+	items := []GetArticlesByFeedRow{}
+	for rows.Next() {
+		var i GetArticlesByFeedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArticlesByIDs = `-- name: GetArticlesByIDs :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.user_id = ?1 AND a.id IN (/*SLICE:ids*/?)
+ORDER BY a.id
+`
+
+type GetArticlesByIDsParams struct {
+	UserID int64
+	Ids    []int64
+}
+
+func (q *Queries) GetArticlesByIDs(ctx context.Context, arg GetArticlesByIDsParams) ([]Article, error) {
+	query := getArticlesByIDs
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.UserID)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 	items := []Article{}
 	for rows.Next() {
 		var i Article
@@ -178,6 +407,9 @@ func (q *Queries) GetArticlesByFeed(ctx context.Context, feedID int64) ([]Articl
 			&i.Title,
 			&i.Url,
 			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
 		); err != nil {
 			return nil, err
 		}
@@ -195,7 +427,9 @@ func (q *Queries) GetArticlesByFeed(ctx context.Context, feedID int64) ([]Articl
 const getReadArticles = `-- name: GetReadArticles :many
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.is_read = 1 AND f.is_subscribed = 1 AND f.user_id = ?
@@ -204,16 +438,19 @@ LIMIT 100
 `
 
 type GetReadArticlesRow struct {
-	ID               int64
-	FeedID           int64
-	Guid             string
-	Title            string
-	Url              string
-	IsRead           int64
-	FeedID2          int64
-	FeedUrl          string
-	FeedTitle        string
-	FeedIsSubscribed int64
+	ID                      int64
+	FeedID                  int64
+	Guid                    string
+	Title                   string
+	Url                     string
+	IsRead                  int64
+	FeedID2                 int64
+	FeedUrl                 string
+	FeedTitle               string
+	FeedIsSubscribed        int64
+	FeedFetchFullContent    int64
+	FeedFetchTimeoutSeconds int64
+	FeedFolderID            sql.NullInt64
 }
 
 func (q *Queries) GetReadArticles(ctx context.Context, userID int64) ([]GetReadArticlesRow, error) {
@@ -236,6 +473,9 @@ func (q *Queries) GetReadArticles(ctx context.Context, userID int64) ([]GetReadA
 			&i.FeedUrl,
 			&i.FeedTitle,
 			&i.FeedIsSubscribed,
+			&i.FeedFetchFullContent,
+			&i.FeedFetchTimeoutSeconds,
+			&i.FeedFolderID,
 		); err != nil {
 			return nil, err
 		}
@@ -250,10 +490,218 @@ func (q *Queries) GetReadArticles(ctx context.Context, userID int64) ([]GetReadA
 	return items, nil
 }
 
+const getStarredArticleIDs = `-- name: GetStarredArticleIDs :many
+SELECT a.id
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_starred = 1 AND f.is_subscribed = 1 AND f.user_id = ?
+ORDER BY a.id
+`
+
+func (q *Queries) GetStarredArticleIDs(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getStarredArticleIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStreamArticles = `-- name: GetStreamArticles :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?1
+    AND (CAST(?2 AS INTEGER) = 0 OR a.feed_id = ?2)
+    AND (CAST(?3 AS INTEGER) = 0 OR f.folder_id = ?3)
+    AND (CAST(?4 AS INTEGER) = -1 OR a.is_read = ?4)
+    AND (CAST(?5 AS INTEGER) = 0 OR a.is_starred = 1)
+    AND a.id > ?6 AND a.id < ?7
+    AND a.published_at >= ?8 AND a.published_at < ?9
+ORDER BY a.id DESC
+LIMIT ?10
+`
+
+type GetStreamArticlesParams struct {
+	UserID          int64
+	FeedID          int64
+	FolderID        int64
+	IsRead          int64
+	StarredOnly     int64
+	MinID           int64
+	MaxID           int64
+	PublishedSince  string
+	PublishedBefore string
+	Limit           int64
+}
+
+func (q *Queries) GetStreamArticles(ctx context.Context, arg GetStreamArticlesParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, getStreamArticles,
+		arg.UserID,
+		arg.FeedID,
+		arg.FolderID,
+		arg.IsRead,
+		arg.StarredOnly,
+		arg.MinID,
+		arg.MaxID,
+		arg.PublishedSince,
+		arg.PublishedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Article{}
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStreamArticlesOldestFirst = `-- name: GetStreamArticlesOldestFirst :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?1
+    AND (CAST(?2 AS INTEGER) = 0 OR a.feed_id = ?2)
+    AND (CAST(?3 AS INTEGER) = 0 OR f.folder_id = ?3)
+    AND (CAST(?4 AS INTEGER) = -1 OR a.is_read = ?4)
+    AND (CAST(?5 AS INTEGER) = 0 OR a.is_starred = 1)
+    AND a.id > ?6 AND a.id < ?7
+    AND a.published_at >= ?8 AND a.published_at < ?9
+ORDER BY a.id ASC
+LIMIT ?10
+`
+
+type GetStreamArticlesOldestFirstParams struct {
+	UserID          int64
+	FeedID          int64
+	FolderID        int64
+	IsRead          int64
+	StarredOnly     int64
+	MinID           int64
+	MaxID           int64
+	PublishedSince  string
+	PublishedBefore string
+	Limit           int64
+}
+
+func (q *Queries) GetStreamArticlesOldestFirst(ctx context.Context, arg GetStreamArticlesOldestFirstParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, getStreamArticlesOldestFirst,
+		arg.UserID,
+		arg.FeedID,
+		arg.FolderID,
+		arg.IsRead,
+		arg.StarredOnly,
+		arg.MinID,
+		arg.MaxID,
+		arg.PublishedSince,
+		arg.PublishedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Article{}
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnreadArticleIDs = `-- name: GetUnreadArticleIDs :many
+SELECT a.id
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
+ORDER BY a.id
+`
+
+func (q *Queries) GetUnreadArticleIDs(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getUnreadArticleIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnreadArticles = `-- name: GetUnreadArticles :many
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
@@ -262,16 +710,19 @@ LIMIT 100
 `
 
 type GetUnreadArticlesRow struct {
-	ID               int64
-	FeedID           int64
-	Guid             string
-	Title            string
-	Url              string
-	IsRead           int64
-	FeedID2          int64
-	FeedUrl          string
-	FeedTitle        string
-	FeedIsSubscribed int64
+	ID                      int64
+	FeedID                  int64
+	Guid                    string
+	Title                   string
+	Url                     string
+	IsRead                  int64
+	FeedID2                 int64
+	FeedUrl                 string
+	FeedTitle               string
+	FeedIsSubscribed        int64
+	FeedFetchFullContent    int64
+	FeedFetchTimeoutSeconds int64
+	FeedFolderID            sql.NullInt64
 }
 
 func (q *Queries) GetUnreadArticles(ctx context.Context, userID int64) ([]GetUnreadArticlesRow, error) {
@@ -294,6 +745,9 @@ func (q *Queries) GetUnreadArticles(ctx context.Context, userID int64) ([]GetUnr
 			&i.FeedUrl,
 			&i.FeedTitle,
 			&i.FeedIsSubscribed,
+			&i.FeedFetchFullContent,
+			&i.FeedFetchTimeoutSeconds,
+			&i.FeedFolderID,
 		); err != nil {
 			return nil, err
 		}
@@ -308,6 +762,62 @@ func (q *Queries) GetUnreadArticles(ctx context.Context, userID int64) ([]GetUnr
 	return items, nil
 }
 
+const getUnreadCountsByFeed = `-- name: GetUnreadCountsByFeed :many
+SELECT a.feed_id, COUNT(*) AS unread_count, CAST(MAX(a.published_at) AS TEXT) AS newest_published_at
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
+GROUP BY a.feed_id
+ORDER BY a.feed_id
+`
+
+type GetUnreadCountsByFeedRow struct {
+	FeedID            int64
+	UnreadCount       int64
+	NewestPublishedAt string
+}
+
+func (q *Queries) GetUnreadCountsByFeed(ctx context.Context, userID int64) ([]GetUnreadCountsByFeedRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUnreadCountsByFeed, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetUnreadCountsByFeedRow{}
+	for rows.Next() {
+		var i GetUnreadCountsByFeedRow
+		if err := rows.Scan(&i.FeedID, &i.UnreadCount, &i.NewestPublishedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllArticlesReadBefore = `-- name: MarkAllArticlesReadBefore :exec
+UPDATE articles
+SET is_read = 1
+WHERE published_at <= ?1 AND feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?2
+)
+`
+
+type MarkAllArticlesReadBeforeParams struct {
+	Before string
+	UserID int64
+}
+
+func (q *Queries) MarkAllArticlesReadBefore(ctx context.Context, arg MarkAllArticlesReadBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, markAllArticlesReadBefore, arg.Before, arg.UserID)
+	return err
+}
+
 const markFeedArticlesRead = `-- name: MarkFeedArticlesRead :exec
 UPDATE articles
 SET is_read = 1
@@ -319,6 +829,22 @@ func (q *Queries) MarkFeedArticlesRead(ctx context.Context, feedID int64) error
 	return err
 }
 
+const markFeedArticlesReadBefore = `-- name: MarkFeedArticlesReadBefore :exec
+UPDATE articles
+SET is_read = 1
+WHERE feed_id = ? AND published_at <= ?
+`
+
+type MarkFeedArticlesReadBeforeParams struct {
+	FeedID      int64
+	PublishedAt string
+}
+
+func (q *Queries) MarkFeedArticlesReadBefore(ctx context.Context, arg MarkFeedArticlesReadBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, markFeedArticlesReadBefore, arg.FeedID, arg.PublishedAt)
+	return err
+}
+
 const markFeedArticlesUnread = `-- name: MarkFeedArticlesUnread :exec
 UPDATE articles
 SET is_read = 0
@@ -330,6 +856,55 @@ func (q *Queries) MarkFeedArticlesUnread(ctx context.Context, feedID int64) erro
 	return err
 }
 
+const searchArticles = `-- name: SearchArticles :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?1
+    AND LOWER(a.title) LIKE ?2
+ORDER BY a.id DESC
+LIMIT ?3
+`
+
+type SearchArticlesParams struct {
+	UserID  int64
+	Pattern string
+	Limit   int64
+}
+
+func (q *Queries) SearchArticles(ctx context.Context, arg SearchArticlesParams) ([]Article, error) {
+	rows, err := q.db.QueryContext(ctx, searchArticles, arg.UserID, arg.Pattern, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Article{}
+	for rows.Next() {
+		var i Article
+		if err := rows.Scan(
+			&i.ID,
+			&i.FeedID,
+			&i.Guid,
+			&i.Title,
+			&i.Url,
+			&i.IsRead,
+			&i.IsStarred,
+			&i.PublishedAt,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateArticle = `-- name: UpdateArticle :exec
 UPDATE articles
 SET title = ?, url = ?
@@ -353,6 +928,22 @@ func (q *Queries) UpdateArticle(ctx context.Context, arg UpdateArticleParams) er
 	return err
 }
 
+const updateArticleContent = `-- name: UpdateArticleContent :exec
+UPDATE articles
+SET content = ?
+WHERE id = ?
+`
+
+type UpdateArticleContentParams struct {
+	Content string
+	ID      int64
+}
+
+func (q *Queries) UpdateArticleContent(ctx context.Context, arg UpdateArticleContentParams) error {
+	_, err := q.db.ExecContext(ctx, updateArticleContent, arg.Content, arg.ID)
+	return err
+}
+
 const updateArticleReadStatus = `-- name: UpdateArticleReadStatus :exec
 UPDATE articles
 SET is_read = ?
@@ -368,3 +959,19 @@ func (q *Queries) UpdateArticleReadStatus(ctx context.Context, arg UpdateArticle
 	_, err := q.db.ExecContext(ctx, updateArticleReadStatus, arg.IsRead, arg.ID)
 	return err
 }
+
+const updateArticleStarredStatus = `-- name: UpdateArticleStarredStatus :exec
+UPDATE articles
+SET is_starred = ?
+WHERE id = ?
+`
+
+type UpdateArticleStarredStatusParams struct {
+	IsStarred int64
+	ID        int64
+}
+
+func (q *Queries) UpdateArticleStarredStatus(ctx context.Context, arg UpdateArticleStarredStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateArticleStarredStatus, arg.IsStarred, arg.ID)
+	return err
+}
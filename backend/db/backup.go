@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+var ErrBackupUnsupported = errors.New("backups are supported for SQLite only; back up PostgreSQL with pg_dump")
+
+// Backup copies the SQLite database to the new file at path with SQLite's online backup API. The copy is
+// consistent even while other connections and processes write to the database.
+func (d *DB) Backup(ctx context.Context, path string) error {
+	if d.Dialect != DialectSQLite {
+		return ErrBackupUnsupported
+	}
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup file already exists: %s", path)
+	}
+
+	dest, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer dest.Close()
+	destConn, err := dest.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer destConn.Close()
+	srcConn, err := d.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer srcConn.Close()
+
+	return destConn.Raw(func(destDriverConn any) error {
+		return srcConn.Raw(func(srcDriverConn any) error {
+			backup, err := destDriverConn.(*sqlite3.SQLiteConn).Backup("main", srcDriverConn.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return fmt.Errorf("failed to start backup: %w", err)
+			}
+			// Copy all pages in one step. Copying in smaller steps would restart whenever another process writes.
+			if _, err := backup.Step(-1); err != nil {
+				backup.Finish()
+				return fmt.Errorf("failed to copy database: %w", err)
+			}
+			if err := backup.Finish(); err != nil {
+				return fmt.Errorf("failed to finish backup: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// ValidateBackup checks that the SQLite database at path is intact and has the schema version this build expects,
+// so that it can replace the database.
+func ValidateBackup(path string) error {
+	// Opening a missing file would create an empty database
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	backup, err := Open(fmt.Sprintf("file:%s?mode=ro", path))
+	if err != nil {
+		return err
+	}
+	defer backup.Close()
+
+	var result string
+	if err := backup.QueryRow("PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("failed to check integrity: %w", err)
+	}
+	if result != "ok" {
+		return fmt.Errorf("integrity check failed: %s", result)
+	}
+	return ValidateSchemaVersion(backup)
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectPostgres Dialect = "postgres"
+)
+
+// DB is a database connection together with the SQL dialect it speaks.
+type DB struct {
+	*sql.DB
+	Dialect Dialect
+}
+
+// IsPostgresDSN reports whether dsn is a PostgreSQL connection URL rather than the path of a SQLite database.
+func IsPostgresDSN(dsn string) bool {
+	return strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://")
+}
+
+// Open opens a PostgreSQL database if dsn is a postgres:// URL, and the SQLite database at the path dsn otherwise.
+func Open(dsn string) (*DB, error) {
+	driver, dialect := "sqlite3", DialectSQLite
+	if IsPostgresDSN(dsn) {
+		driver, dialect = "pgx", DialectPostgres
+	}
+	database, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", dialect, err)
+	}
+	return &DB{DB: database, Dialect: dialect}, nil
+}
+
+// Rebind converts the "?" and "?NNN" placeholders of a query written for SQLite to the placeholders of the dialect.
+func (d Dialect) Rebind(query string) string {
+	if d != DialectPostgres || !strings.Contains(query, "?") {
+		return query
+	}
+
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+	// Like SQLite, a bare "?" is numbered one greater than the largest number used so far
+	maxParam := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			// Copy a string literal or quoted identifier as is
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+1])
+			i += end
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+4])
+			i += end + 3
+		case c == '?':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			n := maxParam + 1
+			if j > i+1 {
+				n, _ = strconv.Atoi(query[i+1 : j])
+			}
+			maxParam = max(maxParam, n)
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			i = j - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// rebindDB runs the queries written for SQLite on a database of another dialect.
+type rebindDB struct {
+	db      DBTX
+	dialect Dialect
+}
+
+func (d *rebindDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.db.ExecContext(ctx, d.dialect.Rebind(query), args...)
+}
+
+func (d *rebindDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return d.db.PrepareContext(ctx, d.dialect.Rebind(query))
+}
+
+func (d *rebindDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.db.QueryContext(ctx, d.dialect.Rebind(query), args...)
+}
+
+func (d *rebindDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.db.QueryRowContext(ctx, d.dialect.Rebind(query), args...)
+}
@@ -0,0 +1,64 @@
+package db
+
+import "testing"
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "bare placeholders",
+			query: "SELECT * FROM feeds WHERE id = ? AND user_id = ?",
+			want:  "SELECT * FROM feeds WHERE id = $1 AND user_id = $2",
+		},
+		{
+			name:  "numbered placeholders",
+			query: "UPDATE feeds SET title = ?2 WHERE id = ?1 AND user_id = ?3",
+			want:  "UPDATE feeds SET title = $2 WHERE id = $1 AND user_id = $3",
+		},
+		{
+			// sqlc numbers repeated sqlc.arg()s, and a bare "?" after them takes the next number as in SQLite
+			name:  "bare placeholder after numbered ones",
+			query: "WHERE (CAST(?1 AS INTEGER) = 0 OR feed_id = ?1) AND id < ? LIMIT ?",
+			want:  "WHERE (CAST($1 AS INTEGER) = 0 OR feed_id = $1) AND id < $2 LIMIT $3",
+		},
+		{
+			name:  "string literal",
+			query: "SELECT '?', \"a?b\" FROM t WHERE x = ? AND y = 'it''s ?'",
+			want:  "SELECT '?', \"a?b\" FROM t WHERE x = $1 AND y = 'it''s ?'",
+		},
+		{
+			name:  "line comment",
+			query: "-- name: GetFeed :one\n-- Is it subscribed?\nSELECT * FROM feeds WHERE id = ?",
+			want:  "-- name: GetFeed :one\n-- Is it subscribed?\nSELECT * FROM feeds WHERE id = $1",
+		},
+		{
+			name:  "block comment",
+			query: "SELECT /* why? */ id FROM feeds WHERE id = ? /* done? */",
+			want:  "SELECT /* why? */ id FROM feeds WHERE id = $1 /* done? */",
+		},
+		{
+			name:  "unterminated literal",
+			query: "SELECT ? WHERE x = 'a?",
+			want:  "SELECT $1 WHERE x = 'a?",
+		},
+		{
+			name:  "no placeholders",
+			query: "SELECT 1",
+			want:  "SELECT 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DialectPostgres.Rebind(tt.query); got != tt.want {
+				t.Errorf("Rebind(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+			if got := DialectSQLite.Rebind(tt.query); got != tt.query {
+				t.Errorf("SQLite Rebind(%q) = %q, want the query unchanged", tt.query, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: feed_icons.sql
+
+package db
+
+import (
+	"context"
+)
+
+const deleteFeedIconsByUser = `-- name: DeleteFeedIconsByUser :exec
+DELETE FROM feed_icons
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+)
+`
+
+func (q *Queries) DeleteFeedIconsByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteFeedIconsByUser, userID)
+	return err
+}
+
+const getFeedIcon = `-- name: GetFeedIcon :one
+SELECT feed_id, data, updated_at
+FROM feed_icons
+WHERE feed_id = ?
+`
+
+func (q *Queries) GetFeedIcon(ctx context.Context, feedID int64) (FeedIcon, error) {
+	row := q.db.QueryRowContext(ctx, getFeedIcon, feedID)
+	var i FeedIcon
+	err := row.Scan(&i.FeedID, &i.Data, &i.UpdatedAt)
+	return i, err
+}
+
+const getFeedIconUpdatedAt = `-- name: GetFeedIconUpdatedAt :one
+SELECT updated_at
+FROM feed_icons
+WHERE feed_id = ? AND length(data) > 0
+`
+
+func (q *Queries) GetFeedIconUpdatedAt(ctx context.Context, feedID int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getFeedIconUpdatedAt, feedID)
+	var updated_at string
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const getFeedIconsByUser = `-- name: GetFeedIconsByUser :many
+SELECT fi.feed_id, fi.data, fi.updated_at
+FROM feed_icons AS fi
+INNER JOIN feeds AS f ON fi.feed_id = f.id
+WHERE f.user_id = ? AND length(fi.data) > 0
+ORDER BY fi.feed_id
+`
+
+func (q *Queries) GetFeedIconsByUser(ctx context.Context, userID int64) ([]FeedIcon, error) {
+	rows, err := q.db.QueryContext(ctx, getFeedIconsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeedIcon{}
+	for rows.Next() {
+		var i FeedIcon
+		if err := rows.Scan(&i.FeedID, &i.Data, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeedIcon = `-- name: UpsertFeedIcon :exec
+INSERT INTO feed_icons (feed_id, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT (feed_id) DO UPDATE
+SET data = excluded.data, updated_at = excluded.updated_at
+`
+
+type UpsertFeedIconParams struct {
+	FeedID    int64
+	Data      []byte
+	UpdatedAt string
+}
+
+func (q *Queries) UpsertFeedIcon(ctx context.Context, arg UpsertFeedIconParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFeedIcon, arg.FeedID, arg.Data, arg.UpdatedAt)
+	return err
+}
@@ -7,12 +7,32 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const checkSubscribedFeedExistsByURL = `-- name: CheckSubscribedFeedExistsByURL :one
+SELECT CAST(EXISTS(
+    SELECT 1 FROM feeds
+    WHERE url = ? AND user_id = ? AND is_subscribed = 1
+) AS INTEGER) as feed_exists
+`
+
+type CheckSubscribedFeedExistsByURLParams struct {
+	Url    string
+	UserID int64
+}
+
+func (q *Queries) CheckSubscribedFeedExistsByURL(ctx context.Context, arg CheckSubscribedFeedExistsByURLParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, checkSubscribedFeedExistsByURL, arg.Url, arg.UserID)
+	var feed_exists int64
+	err := row.Scan(&feed_exists)
+	return feed_exists, err
+}
+
 const createFeed = `-- name: CreateFeed :one
 INSERT INTO feeds (url, title, fetched_at, user_id)
 VALUES (?, ?, ?, ?)
-RETURNING id, url, title, fetched_at, is_subscribed, user_id
+RETURNING id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 `
 
 type CreateFeedParams struct {
@@ -37,6 +57,9 @@ func (q *Queries) CreateFeed(ctx context.Context, arg CreateFeedParams) (Feed, e
 		&i.FetchedAt,
 		&i.IsSubscribed,
 		&i.UserID,
+		&i.FetchFullContent,
+		&i.FetchTimeoutSeconds,
+		&i.FolderID,
 	)
 	return i, err
 }
@@ -51,8 +74,18 @@ func (q *Queries) DeleteFeed(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteFeedsByUser = `-- name: DeleteFeedsByUser :exec
+DELETE FROM feeds
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteFeedsByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteFeedsByUser, userID)
+	return err
+}
+
 const getFeed = `-- name: GetFeed :one
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE id = ?
 `
@@ -67,12 +100,15 @@ func (q *Queries) GetFeed(ctx context.Context, id int64) (Feed, error) {
 		&i.FetchedAt,
 		&i.IsSubscribed,
 		&i.UserID,
+		&i.FetchFullContent,
+		&i.FetchTimeoutSeconds,
+		&i.FolderID,
 	)
 	return i, err
 }
 
 const getFeedByURL = `-- name: GetFeedByURL :one
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE url = ? AND user_id = ?
 `
@@ -92,12 +128,15 @@ func (q *Queries) GetFeedByURL(ctx context.Context, arg GetFeedByURLParams) (Fee
 		&i.FetchedAt,
 		&i.IsSubscribed,
 		&i.UserID,
+		&i.FetchFullContent,
+		&i.FetchTimeoutSeconds,
+		&i.FolderID,
 	)
 	return i, err
 }
 
 const getFeeds = `-- name: GetFeeds :many
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE is_subscribed = 1 AND user_id = ?
 ORDER BY id
@@ -119,6 +158,9 @@ func (q *Queries) GetFeeds(ctx context.Context, userID int64) ([]Feed, error) {
 			&i.FetchedAt,
 			&i.IsSubscribed,
 			&i.UserID,
+			&i.FetchFullContent,
+			&i.FetchTimeoutSeconds,
+			&i.FolderID,
 		); err != nil {
 			return nil, err
 		}
@@ -133,17 +175,61 @@ func (q *Queries) GetFeeds(ctx context.Context, userID int64) ([]Feed, error) {
 	return items, nil
 }
 
-const getFeedsToFetch = `-- name: GetFeedsToFetch :many
-SELECT id, url, fetched_at, user_id
+const getFeedsByFolder = `-- name: GetFeedsByFolder :many
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
-WHERE is_subscribed = 1
+WHERE is_subscribed = 1 AND folder_id = ?
+ORDER BY id
+`
+
+func (q *Queries) GetFeedsByFolder(ctx context.Context, folderID sql.NullInt64) ([]Feed, error) {
+	rows, err := q.db.QueryContext(ctx, getFeedsByFolder, folderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Feed{}
+	for rows.Next() {
+		var i Feed
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Title,
+			&i.FetchedAt,
+			&i.IsSubscribed,
+			&i.UserID,
+			&i.FetchFullContent,
+			&i.FetchTimeoutSeconds,
+			&i.FolderID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFeedsToFetch = `-- name: GetFeedsToFetch :many
+SELECT f.id, f.url, f.fetched_at, f.user_id, w.state as websub_state, w.lease_expires_at as websub_lease_expires_at
+FROM feeds AS f
+INNER JOIN users AS u ON f.user_id = u.id
+LEFT JOIN websub_subscriptions AS w ON f.id = w.feed_id
+WHERE f.is_subscribed = 1 AND u.disabled = 0
 `
 
 type GetFeedsToFetchRow struct {
-	ID        int64
-	Url       string
-	FetchedAt string
-	UserID    int64
+	ID                   int64
+	Url                  string
+	FetchedAt            string
+	UserID               int64
+	WebsubState          sql.NullString
+	WebsubLeaseExpiresAt sql.NullString
 }
 
 func (q *Queries) GetFeedsToFetch(ctx context.Context) ([]GetFeedsToFetchRow, error) {
@@ -160,6 +246,8 @@ func (q *Queries) GetFeedsToFetch(ctx context.Context) ([]GetFeedsToFetchRow, er
 			&i.Url,
 			&i.FetchedAt,
 			&i.UserID,
+			&i.WebsubState,
+			&i.WebsubLeaseExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -185,6 +273,54 @@ func (q *Queries) UnsubscribeFeed(ctx context.Context, id int64) error {
 	return err
 }
 
+const updateFeedFetchFullContent = `-- name: UpdateFeedFetchFullContent :exec
+UPDATE feeds
+SET fetch_full_content = ?
+WHERE id = ?
+`
+
+type UpdateFeedFetchFullContentParams struct {
+	FetchFullContent int64
+	ID               int64
+}
+
+func (q *Queries) UpdateFeedFetchFullContent(ctx context.Context, arg UpdateFeedFetchFullContentParams) error {
+	_, err := q.db.ExecContext(ctx, updateFeedFetchFullContent, arg.FetchFullContent, arg.ID)
+	return err
+}
+
+const updateFeedFetchTimeout = `-- name: UpdateFeedFetchTimeout :exec
+UPDATE feeds
+SET fetch_timeout_seconds = ?
+WHERE id = ?
+`
+
+type UpdateFeedFetchTimeoutParams struct {
+	FetchTimeoutSeconds int64
+	ID                  int64
+}
+
+func (q *Queries) UpdateFeedFetchTimeout(ctx context.Context, arg UpdateFeedFetchTimeoutParams) error {
+	_, err := q.db.ExecContext(ctx, updateFeedFetchTimeout, arg.FetchTimeoutSeconds, arg.ID)
+	return err
+}
+
+const updateFeedFolder = `-- name: UpdateFeedFolder :exec
+UPDATE feeds
+SET folder_id = ?
+WHERE id = ?
+`
+
+type UpdateFeedFolderParams struct {
+	FolderID sql.NullInt64
+	ID       int64
+}
+
+func (q *Queries) UpdateFeedFolder(ctx context.Context, arg UpdateFeedFolderParams) error {
+	_, err := q.db.ExecContext(ctx, updateFeedFolder, arg.FolderID, arg.ID)
+	return err
+}
+
 const updateFeedMetadata = `-- name: UpdateFeedMetadata :exec
 UPDATE feeds
 SET title = ?, fetched_at = ?
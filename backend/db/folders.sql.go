@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: folders.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const clearFeedsFolder = `-- name: ClearFeedsFolder :exec
+UPDATE feeds
+SET folder_id = NULL
+WHERE folder_id = ?
+`
+
+func (q *Queries) ClearFeedsFolder(ctx context.Context, folderID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, clearFeedsFolder, folderID)
+	return err
+}
+
+const createFolder = `-- name: CreateFolder :one
+INSERT INTO folders (user_id, name, created_at)
+VALUES (?, ?, ?)
+RETURNING id, user_id, name, created_at
+`
+
+type CreateFolderParams struct {
+	UserID    int64
+	Name      string
+	CreatedAt string
+}
+
+func (q *Queries) CreateFolder(ctx context.Context, arg CreateFolderParams) (Folder, error) {
+	row := q.db.QueryRowContext(ctx, createFolder, arg.UserID, arg.Name, arg.CreatedAt)
+	var i Folder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteFolder = `-- name: DeleteFolder :exec
+DELETE FROM folders
+WHERE id = ?
+`
+
+func (q *Queries) DeleteFolder(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteFolder, id)
+	return err
+}
+
+const deleteFoldersByUser = `-- name: DeleteFoldersByUser :exec
+DELETE FROM folders
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteFoldersByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteFoldersByUser, userID)
+	return err
+}
+
+const getFolder = `-- name: GetFolder :one
+SELECT id, user_id, name, created_at
+FROM folders
+WHERE id = ?
+`
+
+func (q *Queries) GetFolder(ctx context.Context, id int64) (Folder, error) {
+	row := q.db.QueryRowContext(ctx, getFolder, id)
+	var i Folder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFolderByName = `-- name: GetFolderByName :one
+SELECT id, user_id, name, created_at
+FROM folders
+WHERE user_id = ? AND name = ?
+`
+
+type GetFolderByNameParams struct {
+	UserID int64
+	Name   string
+}
+
+func (q *Queries) GetFolderByName(ctx context.Context, arg GetFolderByNameParams) (Folder, error) {
+	row := q.db.QueryRowContext(ctx, getFolderByName, arg.UserID, arg.Name)
+	var i Folder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFolders = `-- name: GetFolders :many
+SELECT id, user_id, name, created_at
+FROM folders
+WHERE user_id = ?
+ORDER BY name, id
+`
+
+func (q *Queries) GetFolders(ctx context.Context, userID int64) ([]Folder, error) {
+	rows, err := q.db.QueryContext(ctx, getFolders, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Folder{}
+	for rows.Next() {
+		var i Folder
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameFolder = `-- name: RenameFolder :exec
+UPDATE folders
+SET name = ?
+WHERE id = ?
+`
+
+type RenameFolderParams struct {
+	Name string
+	ID   int64
+}
+
+func (q *Queries) RenameFolder(ctx context.Context, arg RenameFolderParams) error {
+	_, err := q.db.ExecContext(ctx, renameFolder, arg.Name, arg.ID)
+	return err
+}
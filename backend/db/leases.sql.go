@@ -0,0 +1,71 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: leases.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createLease = `-- name: CreateLease :execrows
+INSERT INTO leases (name, holder, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (name) DO NOTHING
+`
+
+type CreateLeaseParams struct {
+	Name      string
+	Holder    string
+	ExpiresAt string
+}
+
+func (q *Queries) CreateLease(ctx context.Context, arg CreateLeaseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createLease, arg.Name, arg.Holder, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const extendLease = `-- name: ExtendLease :execrows
+UPDATE leases
+SET holder = ?1, expires_at = ?2
+WHERE name = ?3 AND (holder = ?1 OR expires_at < ?4)
+`
+
+type ExtendLeaseParams struct {
+	Holder    string
+	ExpiresAt string
+	Name      string
+	Now       string
+}
+
+func (q *Queries) ExtendLease(ctx context.Context, arg ExtendLeaseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, extendLease,
+		arg.Holder,
+		arg.ExpiresAt,
+		arg.Name,
+		arg.Now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseLease = `-- name: ReleaseLease :exec
+DELETE FROM leases
+WHERE name = ? AND holder = ?
+`
+
+type ReleaseLeaseParams struct {
+	Name   string
+	Holder string
+}
+
+func (q *Queries) ReleaseLease(ctx context.Context, arg ReleaseLeaseParams) error {
+	_, err := q.db.ExecContext(ctx, releaseLease, arg.Name, arg.Holder)
+	return err
+}
@@ -1,39 +1,110 @@
 package db
 
 import (
+	"crypto/sha256"
 	"database/sql"
 	"embed"
+	"encoding/hex"
 	"fmt"
-	"log"
-	"path/filepath"
+	"log/slog"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
 )
 
-//go:embed migrations/*.sql
+//go:embed migrations/*.sql migrations_postgres/*.sql
 var migrationsFS embed.FS
 
-const EXPECTED_SCHEMA_VERSION = 4
+// Directories of the migrations of each dialect. PostgreSQL is supported from schema version 15, so its first
+// migration creates the whole schema. Every later migration has to be written for both dialects.
+var migrationsDirs = map[Dialect]string{
+	DialectSQLite:   "migrations",
+	DialectPostgres: "migrations_postgres",
+}
+
+// Migrations are named "NNN_description.sql". "NNN_description.down.sql" next to one reverts it.
+const downSuffix = ".down.sql"
 
 type Migration struct {
 	Version  int
 	Filename string
 	SQL      string
+	// SQL of the paired down migration. It is empty if the migration cannot be reverted.
+	DownSQL string
+	// SHA-256 of SQL. It is recorded when the migration is applied, so that later edits of the file are detected.
+	Checksum string
+}
+
+// MigrationStatus describes a migration that is embedded in this build, applied to the database, or both.
+type MigrationStatus struct {
+	Version int
+	// Empty if the migration is applied but not embedded, i.e. the database was migrated by a newer build
+	Filename string
+	Checksum string
+	HasDown  bool
+	Applied  bool
+	// Set if the migration is applied. AppliedChecksum is empty if it was applied before checksums were recorded.
+	AppliedAt       string
+	AppliedChecksum string
 }
 
-func initMigrationTable(db *sql.DB) error {
+// Modified reports whether the file of an applied migration was edited after it was applied.
+func (s MigrationStatus) Modified() bool {
+	return s.Applied && s.Filename != "" && s.AppliedChecksum != "" && s.AppliedChecksum != s.Checksum
+}
+
+type appliedMigration struct {
+	Version   int
+	AppliedAt string
+	Checksum  sql.NullString
+}
+
+func initMigrationTable(db *DB) error {
 	query := `
 	CREATE TABLE IF NOT EXISTS schema_migrations (
 		version INTEGER PRIMARY KEY,
-		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		checksum TEXT
 	);`
 
 	_, err := db.Exec(query)
+	if err != nil {
+		return err
+	}
+
+	// The table of databases migrated before checksums were recorded lacks the column
+	hasChecksum, err := hasColumn(db, "schema_migrations", "checksum")
+	if err != nil {
+		return err
+	}
+	if !hasChecksum {
+		_, err = db.Exec("ALTER TABLE schema_migrations ADD COLUMN checksum TEXT")
+	}
 	return err
 }
 
-func getSchemaVersion(db *sql.DB) (int, error) {
+func hasTable(db *DB, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if db.Dialect == DialectPostgres {
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = ?"
+	}
+	var n int
+	err := db.QueryRow(db.Dialect.Rebind(query), table).Scan(&n)
+	return n > 0, err
+}
+
+func hasColumn(db *DB, table, column string) (bool, error) {
+	query := "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?"
+	if db.Dialect == DialectPostgres {
+		query = "SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ? AND column_name = ?"
+	}
+	var n int
+	err := db.QueryRow(db.Dialect.Rebind(query), table, column).Scan(&n)
+	return n > 0, err
+}
+
+func getSchemaVersion(db *DB) (int, error) {
 	var version int
 	err := db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
 	if err != nil {
@@ -42,27 +113,81 @@ func getSchemaVersion(db *sql.DB) (int, error) {
 	return version, nil
 }
 
-func ValidateSchemaVersion(db *sql.DB) error {
+// getAppliedMigrations returns the applied migrations sorted by version. It does not create the migration table, so
+// that it can be used without changing the database.
+func getAppliedMigrations(db *DB) ([]appliedMigration, error) {
+	exists, err := hasTable(db, "schema_migrations")
+	if err != nil || !exists {
+		return nil, err
+	}
+	hasChecksum, err := hasColumn(db, "schema_migrations", "checksum")
+	if err != nil {
+		return nil, err
+	}
+	query := "SELECT version, applied_at, NULL FROM schema_migrations ORDER BY version"
+	if hasChecksum {
+		query = "SELECT version, applied_at, checksum FROM schema_migrations ORDER BY version"
+	}
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var applied []appliedMigration
+	for rows.Next() {
+		var m appliedMigration
+		if err := rows.Scan(&m.Version, &m.AppliedAt, &m.Checksum); err != nil {
+			return nil, err
+		}
+		applied = append(applied, m)
+	}
+	return applied, rows.Err()
+}
+
+// ExpectedSchemaVersion returns the version of the latest migration of dialect embedded in this build.
+func ExpectedSchemaVersion(dialect Dialect) (int, error) {
+	migrations, err := LoadMigrations(dialect)
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, fmt.Errorf("no migrations for dialect %s", dialect)
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+func ValidateSchemaVersion(db *DB) error {
+	expectedVersion, err := ExpectedSchemaVersion(db.Dialect)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
 	currentVersion, err := getSchemaVersion(db)
 	if err != nil {
 		return fmt.Errorf("failed to get schema version: %w", err)
 	}
 
-	if currentVersion != EXPECTED_SCHEMA_VERSION {
+	if currentVersion != expectedVersion {
 		return fmt.Errorf("schema version mismatch: expected %d, got %d. Run with --migrate to update schema",
-			EXPECTED_SCHEMA_VERSION, currentVersion)
+			expectedVersion, currentVersion)
 	}
 
 	return nil
 }
 
-func LoadMigrations() ([]Migration, error) {
-	entries, err := migrationsFS.ReadDir("migrations")
+// LoadMigrations returns the migrations of dialect sorted by version.
+func LoadMigrations(dialect Dialect) ([]Migration, error) {
+	dir, ok := migrationsDirs[dialect]
+	if !ok {
+		return nil, fmt.Errorf("unsupported dialect: %s", dialect)
+	}
+	entries, err := migrationsFS.ReadDir(dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
 	}
 
 	var migrations []Migration
+	downs := make(map[int]string)
 
 	for _, entry := range entries {
 		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
@@ -77,20 +202,26 @@ func LoadMigrations() ([]Migration, error) {
 
 		version, err := strconv.Atoi(parts[0])
 		if err != nil {
-			log.Printf("Warning: invalid migration filename %s, skipping", entry.Name())
+			slog.Warn("Skipping invalid migration filename", "filename", entry.Name())
 			continue
 		}
 
 		// Read migration SQL
-		sqlBytes, err := migrationsFS.ReadFile(filepath.Join("migrations", entry.Name()))
+		sqlBytes, err := migrationsFS.ReadFile(path.Join(dir, entry.Name()))
 		if err != nil {
 			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
 		}
 
+		if strings.HasSuffix(entry.Name(), downSuffix) {
+			downs[version] = string(sqlBytes)
+			continue
+		}
+		checksum := sha256.Sum256(sqlBytes)
 		migrations = append(migrations, Migration{
 			Version:  version,
 			Filename: entry.Name(),
 			SQL:      string(sqlBytes),
+			Checksum: hex.EncodeToString(checksum[:]),
 		})
 	}
 
@@ -99,45 +230,180 @@ func LoadMigrations() ([]Migration, error) {
 		return migrations[i].Version < migrations[j].Version
 	})
 
+	for i := range migrations {
+		if i > 0 && migrations[i].Version == migrations[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s",
+				migrations[i].Version, migrations[i-1].Filename, migrations[i].Filename)
+		}
+		migrations[i].DownSQL = downs[migrations[i].Version]
+		delete(downs, migrations[i].Version)
+	}
+	if len(downs) > 0 {
+		var versions []int
+		for version := range downs {
+			versions = append(versions, version)
+		}
+		sort.Ints(versions)
+		return nil, fmt.Errorf("down migrations without up migration: %v", versions)
+	}
+
 	return migrations, nil
 }
 
-func RunMigrations(db *sql.DB) error {
-	// Initialize migration table
-	if err := initMigrationTable(db); err != nil {
-		return fmt.Errorf("failed to initialize migration table: %w", err)
+// GetMigrationStatus returns the embedded and applied migrations sorted by version. It does not change the database.
+func GetMigrationStatus(db *DB) ([]MigrationStatus, error) {
+	migrations, err := LoadMigrations(db.Dialect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
 	}
-
-	// Get current version
-	currentVersion, err := getSchemaVersion(db)
+	applied, err := getAppliedMigrations(db)
 	if err != nil {
-		return fmt.Errorf("failed to get current schema version: %w", err)
+		return nil, fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*MigrationStatus)
+	var result []*MigrationStatus
+	for _, m := range migrations {
+		s := &MigrationStatus{
+			Version:  m.Version,
+			Filename: m.Filename,
+			Checksum: m.Checksum,
+			HasDown:  m.DownSQL != "",
+		}
+		byVersion[m.Version] = s
+		result = append(result, s)
+	}
+	for _, a := range applied {
+		s, ok := byVersion[a.Version]
+		if !ok {
+			s = &MigrationStatus{Version: a.Version}
+			result = append(result, s)
+		}
+		s.Applied = true
+		s.AppliedAt = a.AppliedAt
+		s.AppliedChecksum = a.Checksum.String
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	statuses := make([]MigrationStatus, 0, len(result))
+	for _, s := range result {
+		statuses = append(statuses, *s)
 	}
+	return statuses, nil
+}
 
-	// Load all migrations
-	migrations, err := LoadMigrations()
+// checkModified fails if the file of an applied migration was edited after it was applied. The edit is never applied
+// to the database, so the schema would differ from what the queries expect.
+func checkModified(statuses []MigrationStatus) error {
+	var modified []string
+	for _, s := range statuses {
+		if s.Modified() {
+			modified = append(modified, s.Filename)
+		}
+	}
+	if len(modified) > 0 {
+		return fmt.Errorf("applied migrations were edited afterwards: %s", strings.Join(modified, ", "))
+	}
+	return nil
+}
+
+// PendingMigrations returns the migrations RunMigrations would apply. It does not change the database.
+func PendingMigrations(db *DB) ([]Migration, error) {
+	statuses, err := GetMigrationStatus(db)
 	if err != nil {
-		return fmt.Errorf("failed to load migrations: %w", err)
+		return nil, err
+	}
+	if err := checkModified(statuses); err != nil {
+		return nil, err
+	}
+	migrations, err := LoadMigrations(db.Dialect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
 	}
 
-	// Find pending migrations
+	currentVersion := 0
+	for _, s := range statuses {
+		if s.Applied {
+			currentVersion = s.Version
+		}
+	}
 	var pendingMigrations []Migration
 	for _, migration := range migrations {
 		if migration.Version > currentVersion {
 			pendingMigrations = append(pendingMigrations, migration)
 		}
 	}
+	return pendingMigrations, nil
+}
+
+// MigrationsToRollback returns the applied migrations RollbackMigrations would revert to bring the database down to
+// targetVersion, newest first. It does not change the database.
+func MigrationsToRollback(db *DB, targetVersion int) ([]Migration, error) {
+	statuses, err := GetMigrationStatus(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModified(statuses); err != nil {
+		return nil, err
+	}
+	migrations, err := LoadMigrations(db.Dialect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	byVersion := make(map[int]Migration)
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+
+	var result []Migration
+	for i := len(statuses) - 1; i >= 0; i-- {
+		s := statuses[i]
+		if !s.Applied || s.Version <= targetVersion {
+			continue
+		}
+		m, ok := byVersion[s.Version]
+		if !ok {
+			return nil, fmt.Errorf("migration %d is not known to this build", s.Version)
+		}
+		if m.DownSQL == "" {
+			return nil, fmt.Errorf("migration %s has no down migration", m.Filename)
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func RunMigrations(db *DB) error {
+	// Initialize migration table
+	if err := initMigrationTable(db); err != nil {
+		return fmt.Errorf("failed to initialize migration table: %w", err)
+	}
+
+	// Find pending migrations
+	pendingMigrations, err := PendingMigrations(db)
+	if err != nil {
+		return err
+	}
+	if err := recordMissingChecksums(db); err != nil {
+		return fmt.Errorf("failed to record checksums: %w", err)
+	}
 
 	if len(pendingMigrations) == 0 {
-		log.Printf("No pending migrations. Current schema version: %d", currentVersion)
+		currentVersion, err := getSchemaVersion(db)
+		if err != nil {
+			return fmt.Errorf("failed to get current schema version: %w", err)
+		}
+		slog.Info("No pending migrations", "schema_version", currentVersion)
 		return nil
 	}
 
-	log.Printf("Running %d pending migrations...", len(pendingMigrations))
+	slog.Info("Running pending migrations", "count", len(pendingMigrations))
 
 	// Execute each pending migration in a transaction
 	for _, migration := range pendingMigrations {
-		log.Printf("Applying migration %d: %s", migration.Version, migration.Filename)
+		slog.Info("Applying migration", "version", migration.Version, "filename", migration.Filename)
 
 		tx, err := db.Begin()
 		if err != nil {
@@ -153,8 +419,8 @@ func RunMigrations(db *sql.DB) error {
 
 		// Record migration as applied
 		_, err = tx.Exec(
-			"INSERT INTO schema_migrations (version) VALUES (?)",
-			migration.Version,
+			db.Dialect.Rebind("INSERT INTO schema_migrations (version, checksum) VALUES (?, ?)"),
+			migration.Version, migration.Checksum,
 		)
 		if err != nil {
 			tx.Rollback()
@@ -166,9 +432,82 @@ func RunMigrations(db *sql.DB) error {
 			return fmt.Errorf("failed to commit migration %d: %w", migration.Version, err)
 		}
 
-		log.Printf("Successfully applied migration %d", migration.Version)
+		slog.Info("Applied migration", "version", migration.Version)
+	}
+
+	slog.Info("All migrations completed", "schema_version", pendingMigrations[len(pendingMigrations)-1].Version)
+	return nil
+}
+
+// recordMissingChecksums records the checksums of the migrations applied before checksums were recorded. Edits made
+// before then cannot be detected.
+func recordMissingChecksums(db *DB) error {
+	statuses, err := GetMigrationStatus(db)
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if !s.Applied || s.AppliedChecksum != "" || s.Filename == "" {
+			continue
+		}
+		_, err := db.Exec(
+			db.Dialect.Rebind("UPDATE schema_migrations SET checksum = ? WHERE version = ?"),
+			s.Checksum, s.Version,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RollbackMigrations reverts the applied migrations newer than targetVersion with their down migrations, newest
+// first. It fails before reverting anything if one of them has no down migration.
+func RollbackMigrations(db *DB, targetVersion int) error {
+	migrations, err := MigrationsToRollback(db, targetVersion)
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		slog.Info("No migrations to revert", "target_version", targetVersion)
+		return nil
+	}
+
+	// Execute each down migration in a transaction
+	for _, migration := range migrations {
+		slog.Info("Reverting migration", "version", migration.Version, "filename", migration.Filename)
+
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to start transaction for migration %d: %w", migration.Version, err)
+		}
+
+		_, err = tx.Exec(migration.DownSQL)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to revert migration %d: %w", migration.Version, err)
+		}
+
+		_, err = tx.Exec(
+			db.Dialect.Rebind("DELETE FROM schema_migrations WHERE version = ?"),
+			migration.Version,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record reverting migration %d: %w", migration.Version, err)
+		}
+
+		if err = tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit reverting migration %d: %w", migration.Version, err)
+		}
+
+		slog.Info("Reverted migration", "version", migration.Version)
 	}
 
-	log.Printf("All migrations completed. Schema version: %d", EXPECTED_SCHEMA_VERSION)
+	currentVersion, err := getSchemaVersion(db)
+	if err != nil {
+		return fmt.Errorf("failed to get current schema version: %w", err)
+	}
+	slog.Info("All migrations reverted", "schema_version", currentVersion)
 	return nil
 }
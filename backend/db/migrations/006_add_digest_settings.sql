@@ -0,0 +1,18 @@
+-- Add digest_settings and digest_feeds tables for email digests.
+
+-- Digest settings (one row per user)
+CREATE TABLE IF NOT EXISTS digest_settings (
+    user_id         INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email           TEXT NOT NULL,
+    frequency       TEXT NOT NULL DEFAULT 'off',
+    min_articles    INTEGER NOT NULL DEFAULT 1,
+    last_run_at     TEXT,
+    last_article_id INTEGER NOT NULL DEFAULT 0
+);
+
+-- Feeds included in the digest. If a user has no rows here, all feeds are included.
+CREATE TABLE IF NOT EXISTS digest_feeds (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feed_id INTEGER NOT NULL REFERENCES feeds(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, feed_id)
+);
@@ -0,0 +1,11 @@
+-- Add websub_subscriptions table for WebSub (PubSubHubbub) push subscriptions.
+
+CREATE TABLE IF NOT EXISTS websub_subscriptions (
+    feed_id          INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    hub_url          TEXT NOT NULL,
+    topic_url        TEXT NOT NULL,
+    secret           TEXT NOT NULL,
+    state            TEXT NOT NULL DEFAULT 'pending',
+    lease_expires_at TEXT,
+    requested_at     TEXT NOT NULL
+);
@@ -0,0 +1,12 @@
+-- Add columns used by the Fever API.
+
+-- Starred ("saved" in Fever) state and publication time of articles
+ALTER TABLE articles ADD COLUMN is_starred INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE articles ADD COLUMN published_at TEXT NOT NULL DEFAULT '';
+
+-- Fever API key: md5("username:password")
+ALTER TABLE users ADD COLUMN fever_api_key TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_fever_api_key ON users(fever_api_key);
+
+CREATE INDEX IF NOT EXISTS idx_articles_is_starred ON articles(is_starred);
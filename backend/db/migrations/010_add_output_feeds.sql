@@ -0,0 +1,14 @@
+-- Add output_feeds table for feeds generated from a user's articles.
+
+CREATE TABLE IF NOT EXISTS output_feeds (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token      TEXT NOT NULL UNIQUE,
+    title      TEXT NOT NULL,
+    source     TEXT NOT NULL,
+    feed_id    INTEGER REFERENCES feeds(id) ON DELETE CASCADE,
+    query      TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_output_feeds_user_id ON output_feeds(user_id);
@@ -0,0 +1,4 @@
+-- Add article content and the per-feed option to extract full content from article pages.
+
+ALTER TABLE articles ADD COLUMN content TEXT NOT NULL DEFAULT '';
+ALTER TABLE feeds ADD COLUMN fetch_full_content INTEGER NOT NULL DEFAULT 0;
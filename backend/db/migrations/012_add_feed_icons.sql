@@ -0,0 +1,7 @@
+-- Add feed_icons table for favicons of feeds' sites.
+
+CREATE TABLE IF NOT EXISTS feed_icons (
+    feed_id    INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data       BLOB NOT NULL,
+    updated_at TEXT NOT NULL
+);
@@ -0,0 +1,10 @@
+-- Add feed_scrapers table for feeds scraped from pages that have no RSS/Atom feed.
+
+CREATE TABLE IF NOT EXISTS feed_scrapers (
+    feed_id          INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    item_selector    TEXT NOT NULL,
+    title_selector   TEXT NOT NULL DEFAULT '',
+    link_selector    TEXT NOT NULL DEFAULT '',
+    date_selector    TEXT NOT NULL DEFAULT '',
+    content_selector TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,6 @@
+-- Add feed_credentials table for HTTP credentials sent when fetching private feeds.
+
+CREATE TABLE IF NOT EXISTS feed_credentials (
+    feed_id INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data    TEXT NOT NULL
+);
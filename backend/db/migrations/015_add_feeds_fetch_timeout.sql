@@ -0,0 +1,3 @@
+-- Add the per-feed override of the fetch timeout. 0 means the configured default.
+
+ALTER TABLE feeds ADD COLUMN fetch_timeout_seconds INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+-- Add folders table and the folder of each feed. A feed is in at most one folder.
+-- feeds.folder_id has no foreign key so that the down migration can drop it. Deleting a folder clears it explicitly.
+
+CREATE TABLE IF NOT EXISTS folders (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    UNIQUE (user_id, name)
+);
+
+ALTER TABLE feeds ADD COLUMN folder_id INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_feeds_folder_id ON feeds(folder_id);
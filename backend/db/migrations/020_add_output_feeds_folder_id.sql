@@ -0,0 +1,5 @@
+-- Add the folder whose articles a FOLDER output feed contains.
+-- output_feeds.folder_id has no foreign key so that the down migration can drop it. Deleting a folder deletes its
+-- output feeds explicitly.
+
+ALTER TABLE output_feeds ADD COLUMN folder_id INTEGER;
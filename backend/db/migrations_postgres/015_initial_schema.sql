@@ -0,0 +1,119 @@
+-- Create the PostgreSQL schema equivalent to version 15 of the SQLite schema.
+-- Column types and order follow db/schema.sql so that the queries generated from it work unchanged:
+-- IDs are BIGINT, booleans are INTEGER 0/1 and timestamps are TEXT in the same format as SQLite.
+
+CREATE TABLE IF NOT EXISTS users (
+    id            BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    username      TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    created_at    TEXT NOT NULL DEFAULT to_char(now() AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24:MI:SS'),
+    fever_api_key TEXT
+);
+
+CREATE TABLE IF NOT EXISTS feeds (
+    id            BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    url           TEXT NOT NULL,
+    title         TEXT NOT NULL,
+    fetched_at    TEXT NOT NULL,
+    is_subscribed INTEGER NOT NULL DEFAULT 1,
+    user_id       BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    fetch_full_content INTEGER NOT NULL DEFAULT 0,
+    fetch_timeout_seconds INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS articles (
+    id      BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    feed_id BIGINT NOT NULL REFERENCES feeds(id) ON DELETE CASCADE,
+    guid    TEXT NOT NULL,
+    title   TEXT NOT NULL,
+    url     TEXT NOT NULL,
+    is_read INTEGER NOT NULL DEFAULT 0,
+    is_starred   INTEGER NOT NULL DEFAULT 0,
+    published_at TEXT NOT NULL DEFAULT '',
+    content      TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS digest_settings (
+    user_id         BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email           TEXT NOT NULL,
+    frequency       TEXT NOT NULL DEFAULT 'off',
+    min_articles    INTEGER NOT NULL DEFAULT 1,
+    last_run_at     TEXT,
+    last_article_id BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS digest_feeds (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feed_id BIGINT NOT NULL REFERENCES feeds(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, feed_id)
+);
+
+CREATE TABLE IF NOT EXISTS websub_subscriptions (
+    feed_id          BIGINT PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    hub_url          TEXT NOT NULL,
+    topic_url        TEXT NOT NULL,
+    secret           TEXT NOT NULL,
+    state            TEXT NOT NULL DEFAULT 'pending',
+    lease_expires_at TEXT,
+    requested_at     TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id      BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name         TEXT NOT NULL,
+    token_hash   TEXT NOT NULL UNIQUE,
+    scope        TEXT NOT NULL,
+    expires_at   TEXT,
+    last_used_at TEXT,
+    created_at   TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS output_feeds (
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token      TEXT NOT NULL UNIQUE,
+    title      TEXT NOT NULL,
+    source     TEXT NOT NULL,
+    feed_id    BIGINT REFERENCES feeds(id) ON DELETE CASCADE,
+    query      TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS feed_icons (
+    feed_id    BIGINT PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data       BYTEA NOT NULL,
+    updated_at TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS feed_scrapers (
+    feed_id          BIGINT PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    item_selector    TEXT NOT NULL,
+    title_selector   TEXT NOT NULL DEFAULT '',
+    link_selector    TEXT NOT NULL DEFAULT '',
+    date_selector    TEXT NOT NULL DEFAULT '',
+    content_selector TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS feed_credentials (
+    feed_id BIGINT PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data    TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_articles_feed_id ON articles(feed_id);
+
+CREATE INDEX IF NOT EXISTS idx_articles_feed_guid ON articles(feed_id, guid);
+
+CREATE INDEX IF NOT EXISTS idx_articles_is_read ON articles(is_read);
+
+CREATE INDEX IF NOT EXISTS idx_articles_guid ON articles(guid);
+
+CREATE INDEX IF NOT EXISTS idx_feeds_user_id ON feeds(user_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_fever_api_key ON users(fever_api_key);
+
+CREATE INDEX IF NOT EXISTS idx_articles_is_starred ON articles(is_starred);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_user_id ON api_tokens(user_id);
+
+CREATE INDEX IF NOT EXISTS idx_output_feeds_user_id ON output_feeds(user_id);
@@ -0,0 +1,3 @@
+-- Add the disabled flag of users. Disabled users cannot log in and their feeds are not fetched.
+
+ALTER TABLE users ADD COLUMN disabled INTEGER NOT NULL DEFAULT 0;
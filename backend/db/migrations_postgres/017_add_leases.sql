@@ -0,0 +1,8 @@
+-- Add leases table. A lease lets one of the processes sharing the database do a job, e.g. fetch feeds.
+-- It expires unless the holder renews it, so that another process can take over when the holder stops.
+
+CREATE TABLE IF NOT EXISTS leases (
+    name       TEXT PRIMARY KEY,
+    holder     TEXT NOT NULL,
+    expires_at TEXT NOT NULL
+);
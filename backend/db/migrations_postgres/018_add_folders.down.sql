@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_feeds_folder_id;
+ALTER TABLE feeds DROP COLUMN folder_id;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,13 @@
+-- Add folders table and the folder of each feed. A feed is in at most one folder.
+
+CREATE TABLE IF NOT EXISTS folders (
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    UNIQUE (user_id, name)
+);
+
+ALTER TABLE feeds ADD COLUMN folder_id BIGINT REFERENCES folders(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_feeds_folder_id ON feeds(folder_id);
@@ -0,0 +1,7 @@
+-- Add digest_folders table. The feeds of these folders are included in the digest in addition to digest_feeds.
+
+CREATE TABLE IF NOT EXISTS digest_folders (
+    user_id   BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    folder_id BIGINT NOT NULL REFERENCES folders(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, folder_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE output_feeds DROP COLUMN folder_id;
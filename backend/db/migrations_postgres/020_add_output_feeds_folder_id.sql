@@ -0,0 +1,3 @@
+-- Add the folder whose articles a FOLDER output feed contains.
+
+ALTER TABLE output_feeds ADD COLUMN folder_id BIGINT REFERENCES folders(id) ON DELETE CASCADE;
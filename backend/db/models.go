@@ -4,22 +4,107 @@
 
 package db
 
+import (
+	"database/sql"
+)
+
+type ApiToken struct {
+	ID         int64
+	UserID     int64
+	Name       string
+	TokenHash  string
+	Scope      string
+	ExpiresAt  sql.NullString
+	LastUsedAt sql.NullString
+	CreatedAt  string
+}
+
 type Article struct {
-	ID     int64
+	ID          int64
+	FeedID      int64
+	Guid        string
+	Title       string
+	Url         string
+	IsRead      int64
+	IsStarred   int64
+	PublishedAt string
+	Content     string
+}
+
+type DigestFeed struct {
+	UserID int64
 	FeedID int64
-	Guid   string
-	Title  string
-	Url    string
-	IsRead int64
+}
+
+type DigestFolder struct {
+	UserID   int64
+	FolderID int64
+}
+
+type DigestSetting struct {
+	UserID        int64
+	Email         string
+	Frequency     string
+	MinArticles   int64
+	LastRunAt     sql.NullString
+	LastArticleID int64
 }
 
 type Feed struct {
-	ID           int64
-	Url          string
-	Title        string
-	FetchedAt    string
-	IsSubscribed int64
-	UserID       int64
+	ID                  int64
+	Url                 string
+	Title               string
+	FetchedAt           string
+	IsSubscribed        int64
+	UserID              int64
+	FetchFullContent    int64
+	FetchTimeoutSeconds int64
+	FolderID            sql.NullInt64
+}
+
+type FeedCredential struct {
+	FeedID int64
+	Data   string
+}
+
+type FeedIcon struct {
+	FeedID    int64
+	Data      []byte
+	UpdatedAt string
+}
+
+type FeedScraper struct {
+	FeedID          int64
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	ContentSelector string
+}
+
+type Folder struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	CreatedAt string
+}
+
+type Lease struct {
+	Name      string
+	Holder    string
+	ExpiresAt string
+}
+
+type OutputFeed struct {
+	ID        int64
+	UserID    int64
+	Token     string
+	Title     string
+	Source    string
+	FeedID    sql.NullInt64
+	Query     string
+	CreatedAt string
+	FolderID  sql.NullInt64
 }
 
 type User struct {
@@ -27,4 +112,16 @@ type User struct {
 	Username     string
 	PasswordHash string
 	CreatedAt    string
+	FeverApiKey  sql.NullString
+	Disabled     int64
+}
+
+type WebsubSubscription struct {
+	FeedID         int64
+	HubUrl         string
+	TopicUrl       string
+	Secret         string
+	State          string
+	LeaseExpiresAt sql.NullString
+	RequestedAt    string
 }
@@ -0,0 +1,155 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: output_feeds.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createOutputFeed = `-- name: CreateOutputFeed :one
+INSERT INTO output_feeds (user_id, token, title, source, feed_id, folder_id, query, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, user_id, token, title, source, feed_id, "query", created_at, folder_id
+`
+
+type CreateOutputFeedParams struct {
+	UserID    int64
+	Token     string
+	Title     string
+	Source    string
+	FeedID    sql.NullInt64
+	FolderID  sql.NullInt64
+	Query     string
+	CreatedAt string
+}
+
+func (q *Queries) CreateOutputFeed(ctx context.Context, arg CreateOutputFeedParams) (OutputFeed, error) {
+	row := q.db.QueryRowContext(ctx, createOutputFeed,
+		arg.UserID,
+		arg.Token,
+		arg.Title,
+		arg.Source,
+		arg.FeedID,
+		arg.FolderID,
+		arg.Query,
+		arg.CreatedAt,
+	)
+	var i OutputFeed
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Token,
+		&i.Title,
+		&i.Source,
+		&i.FeedID,
+		&i.Query,
+		&i.CreatedAt,
+		&i.FolderID,
+	)
+	return i, err
+}
+
+const deleteOutputFeed = `-- name: DeleteOutputFeed :execrows
+DELETE FROM output_feeds
+WHERE id = ? AND user_id = ?
+`
+
+type DeleteOutputFeedParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteOutputFeed(ctx context.Context, arg DeleteOutputFeedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOutputFeed, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteOutputFeedsByFolder = `-- name: DeleteOutputFeedsByFolder :exec
+DELETE FROM output_feeds
+WHERE folder_id = ?
+`
+
+func (q *Queries) DeleteOutputFeedsByFolder(ctx context.Context, folderID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, deleteOutputFeedsByFolder, folderID)
+	return err
+}
+
+const deleteOutputFeedsByUser = `-- name: DeleteOutputFeedsByUser :exec
+DELETE FROM output_feeds
+WHERE user_id = ?
+`
+
+func (q *Queries) DeleteOutputFeedsByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteOutputFeedsByUser, userID)
+	return err
+}
+
+const getOutputFeedByToken = `-- name: GetOutputFeedByToken :one
+SELECT id, user_id, token, title, source, feed_id, "query", created_at, folder_id
+FROM output_feeds
+WHERE token = ?
+`
+
+func (q *Queries) GetOutputFeedByToken(ctx context.Context, token string) (OutputFeed, error) {
+	row := q.db.QueryRowContext(ctx, getOutputFeedByToken, token)
+	var i OutputFeed
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Token,
+		&i.Title,
+		&i.Source,
+		&i.FeedID,
+		&i.Query,
+		&i.CreatedAt,
+		&i.FolderID,
+	)
+	return i, err
+}
+
+const getOutputFeeds = `-- name: GetOutputFeeds :many
+SELECT id, user_id, token, title, source, feed_id, "query", created_at, folder_id
+FROM output_feeds
+WHERE user_id = ?
+ORDER BY id
+`
+
+func (q *Queries) GetOutputFeeds(ctx context.Context, userID int64) ([]OutputFeed, error) {
+	rows, err := q.db.QueryContext(ctx, getOutputFeeds, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutputFeed{}
+	for rows.Next() {
+		var i OutputFeed
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Token,
+			&i.Title,
+			&i.Source,
+			&i.FeedID,
+			&i.Query,
+			&i.CreatedAt,
+			&i.FolderID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,127 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Querier interface {
+	ActivateWebSubSubscription(ctx context.Context, arg ActivateWebSubSubscriptionParams) error
+	AddDigestFeed(ctx context.Context, arg AddDigestFeedParams) error
+	AddDigestFolder(ctx context.Context, arg AddDigestFolderParams) error
+	CheckArticleExists(ctx context.Context, arg CheckArticleExistsParams) (int64, error)
+	CheckArticleExistsByGUID(ctx context.Context, guid string) (int64, error)
+	CheckFeedCredentialsExist(ctx context.Context, feedID int64) (int64, error)
+	CheckSubscribedFeedExistsByURL(ctx context.Context, arg CheckSubscribedFeedExistsByURLParams) (int64, error)
+	ClearFeedsFolder(ctx context.Context, folderID sql.NullInt64) error
+	CountArticles(ctx context.Context, userID int64) (int64, error)
+	CountUnreadArticles(ctx context.Context, userID int64) (int64, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (ApiToken, error)
+	CreateArticle(ctx context.Context, arg CreateArticleParams) (Article, error)
+	CreateFeed(ctx context.Context, arg CreateFeedParams) (Feed, error)
+	CreateFeedScraper(ctx context.Context, arg CreateFeedScraperParams) error
+	CreateFolder(ctx context.Context, arg CreateFolderParams) (Folder, error)
+	CreateLease(ctx context.Context, arg CreateLeaseParams) (int64, error)
+	CreateOutputFeed(ctx context.Context, arg CreateOutputFeedParams) (OutputFeed, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteAPIToken(ctx context.Context, arg DeleteAPITokenParams) (int64, error)
+	DeleteAPITokensByUser(ctx context.Context, userID int64) error
+	DeleteArticlesByFeed(ctx context.Context, feedID int64) error
+	DeleteArticlesByUser(ctx context.Context, userID int64) error
+	DeleteDigestFeeds(ctx context.Context, userID int64) error
+	DeleteDigestFolders(ctx context.Context, userID int64) error
+	DeleteDigestFoldersByFolder(ctx context.Context, folderID int64) error
+	DeleteDigestSettings(ctx context.Context, userID int64) error
+	DeleteFeed(ctx context.Context, id int64) error
+	DeleteFeedCredentials(ctx context.Context, feedID int64) error
+	DeleteFeedCredentialsByUser(ctx context.Context, userID int64) error
+	DeleteFeedIconsByUser(ctx context.Context, userID int64) error
+	DeleteFeedScrapersByUser(ctx context.Context, userID int64) error
+	DeleteFeedsByUser(ctx context.Context, userID int64) error
+	DeleteFolder(ctx context.Context, id int64) error
+	DeleteFoldersByUser(ctx context.Context, userID int64) error
+	DeleteOutputFeed(ctx context.Context, arg DeleteOutputFeedParams) (int64, error)
+	DeleteOutputFeedsByFolder(ctx context.Context, folderID sql.NullInt64) error
+	DeleteOutputFeedsByUser(ctx context.Context, userID int64) error
+	DeleteUser(ctx context.Context, id int64) error
+	DeleteWebSubSubscription(ctx context.Context, feedID int64) error
+	DeleteWebSubSubscriptionsByUser(ctx context.Context, userID int64) error
+	ExtendLease(ctx context.Context, arg ExtendLeaseParams) (int64, error)
+	GetAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error)
+	GetAPITokens(ctx context.Context, userID int64) ([]ApiToken, error)
+	GetActiveDigestSettings(ctx context.Context) ([]DigestSetting, error)
+	GetArticle(ctx context.Context, id int64) (GetArticleRow, error)
+	GetArticleContent(ctx context.Context, id int64) (string, error)
+	GetArticleGUIDsByFeed(ctx context.Context, feedID int64) ([]string, error)
+	GetArticlesAfterID(ctx context.Context, arg GetArticlesAfterIDParams) ([]Article, error)
+	GetArticlesBeforeID(ctx context.Context, arg GetArticlesBeforeIDParams) ([]Article, error)
+	GetArticlesByFeed(ctx context.Context, feedID int64) ([]GetArticlesByFeedRow, error)
+	GetArticlesByIDs(ctx context.Context, arg GetArticlesByIDsParams) ([]Article, error)
+	GetDigestArticles(ctx context.Context, arg GetDigestArticlesParams) ([]GetDigestArticlesRow, error)
+	GetDigestFeedIDs(ctx context.Context, userID int64) ([]int64, error)
+	GetDigestFolderIDs(ctx context.Context, userID int64) ([]int64, error)
+	GetDigestSettings(ctx context.Context, userID int64) (DigestSetting, error)
+	GetFeed(ctx context.Context, id int64) (Feed, error)
+	GetFeedByURL(ctx context.Context, arg GetFeedByURLParams) (Feed, error)
+	GetFeedCredentials(ctx context.Context, feedID int64) (string, error)
+	GetFeedIcon(ctx context.Context, feedID int64) (FeedIcon, error)
+	GetFeedIconUpdatedAt(ctx context.Context, feedID int64) (string, error)
+	GetFeedIconsByUser(ctx context.Context, userID int64) ([]FeedIcon, error)
+	GetFeedScraper(ctx context.Context, feedID int64) (FeedScraper, error)
+	GetFeeds(ctx context.Context, userID int64) ([]Feed, error)
+	GetFeedsByFolder(ctx context.Context, folderID sql.NullInt64) ([]Feed, error)
+	GetFeedsToFetch(ctx context.Context) ([]GetFeedsToFetchRow, error)
+	GetFolder(ctx context.Context, id int64) (Folder, error)
+	GetFolderByName(ctx context.Context, arg GetFolderByNameParams) (Folder, error)
+	GetFolders(ctx context.Context, userID int64) ([]Folder, error)
+	GetOutputFeedByToken(ctx context.Context, token string) (OutputFeed, error)
+	GetOutputFeeds(ctx context.Context, userID int64) ([]OutputFeed, error)
+	GetReadArticles(ctx context.Context, userID int64) ([]GetReadArticlesRow, error)
+	GetStarredArticleIDs(ctx context.Context, userID int64) ([]int64, error)
+	GetStreamArticles(ctx context.Context, arg GetStreamArticlesParams) ([]Article, error)
+	GetStreamArticlesOldestFirst(ctx context.Context, arg GetStreamArticlesOldestFirstParams) ([]Article, error)
+	GetUnreadArticleIDs(ctx context.Context, userID int64) ([]int64, error)
+	GetUnreadArticles(ctx context.Context, userID int64) ([]GetUnreadArticlesRow, error)
+	GetUnreadCountsByFeed(ctx context.Context, userID int64) ([]GetUnreadCountsByFeedRow, error)
+	GetUserByFeverAPIKey(ctx context.Context, feverApiKey sql.NullString) (GetUserByFeverAPIKeyRow, error)
+	GetUserByID(ctx context.Context, id int64) (GetUserByIDRow, error)
+	GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error)
+	GetUsers(ctx context.Context) ([]GetUsersRow, error)
+	GetWebSubSubscription(ctx context.Context, feedID int64) (WebsubSubscription, error)
+	GetWebSubSubscriptionsToRenew(ctx context.Context, arg GetWebSubSubscriptionsToRenewParams) ([]WebsubSubscription, error)
+	MarkAllArticlesReadBefore(ctx context.Context, arg MarkAllArticlesReadBeforeParams) error
+	MarkFeedArticlesRead(ctx context.Context, feedID int64) error
+	MarkFeedArticlesReadBefore(ctx context.Context, arg MarkFeedArticlesReadBeforeParams) error
+	MarkFeedArticlesUnread(ctx context.Context, feedID int64) error
+	ReleaseLease(ctx context.Context, arg ReleaseLeaseParams) error
+	RenameFolder(ctx context.Context, arg RenameFolderParams) error
+	SearchArticles(ctx context.Context, arg SearchArticlesParams) ([]Article, error)
+	StartDigestAtLatestArticle(ctx context.Context, userID int64) error
+	UnsubscribeFeed(ctx context.Context, id int64) error
+	UpdateAPITokenLastUsed(ctx context.Context, arg UpdateAPITokenLastUsedParams) error
+	UpdateArticle(ctx context.Context, arg UpdateArticleParams) error
+	UpdateArticleContent(ctx context.Context, arg UpdateArticleContentParams) error
+	UpdateArticleReadStatus(ctx context.Context, arg UpdateArticleReadStatusParams) error
+	UpdateArticleStarredStatus(ctx context.Context, arg UpdateArticleStarredStatusParams) error
+	UpdateDigestRun(ctx context.Context, arg UpdateDigestRunParams) error
+	UpdateFeedFetchFullContent(ctx context.Context, arg UpdateFeedFetchFullContentParams) error
+	UpdateFeedFetchTimeout(ctx context.Context, arg UpdateFeedFetchTimeoutParams) error
+	UpdateFeedFolder(ctx context.Context, arg UpdateFeedFolderParams) error
+	UpdateFeedMetadata(ctx context.Context, arg UpdateFeedMetadataParams) error
+	UpdateUserDisabled(ctx context.Context, arg UpdateUserDisabledParams) error
+	UpdateUserFeverAPIKey(ctx context.Context, arg UpdateUserFeverAPIKeyParams) error
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	// The Fever API key is derived from the username, so it stops matching and is cleared.
+	UpdateUsername(ctx context.Context, arg UpdateUsernameParams) error
+	UpdateWebSubSubscriptionState(ctx context.Context, arg UpdateWebSubSubscriptionStateParams) error
+	UpsertDigestSettings(ctx context.Context, arg UpsertDigestSettingsParams) error
+	UpsertFeedCredentials(ctx context.Context, arg UpsertFeedCredentialsParams) error
+	UpsertFeedIcon(ctx context.Context, arg UpsertFeedIconParams) error
+	UpsertWebSubSubscription(ctx context.Context, arg UpsertWebSubSubscriptionParams) error
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,29 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (user_id, name, token_hash, scope, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetAPITokens :many
+SELECT *
+FROM api_tokens
+WHERE user_id = ?
+ORDER BY id;
+
+-- name: GetAPITokenByHash :one
+SELECT t.*
+FROM api_tokens AS t
+INNER JOIN users AS u ON t.user_id = u.id
+WHERE t.token_hash = ? AND u.disabled = 0;
+
+-- name: UpdateAPITokenLastUsed :exec
+UPDATE api_tokens
+SET last_used_at = sqlc.arg(last_used_at)
+WHERE id = sqlc.arg(id) AND (last_used_at IS NULL OR last_used_at < sqlc.arg(threshold));
+
+-- name: DeleteAPIToken :execrows
+DELETE FROM api_tokens
+WHERE id = ? AND user_id = ?;
+
+-- name: DeleteAPITokensByUser :exec
+DELETE FROM api_tokens
+WHERE user_id = ?;
@@ -1,7 +1,9 @@
 -- name: GetArticle :one
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.id = ?;
@@ -9,7 +11,9 @@ WHERE a.id = ?;
 -- name: GetUnreadArticles :many
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
@@ -19,7 +23,9 @@ LIMIT 100;
 -- name: GetReadArticles :many
 SELECT
     a.id, a.feed_id, a.guid, a.title, a.url, a.is_read,
-    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed
+    f.id as feed_id_2, f.url as feed_url, f.title as feed_title, f.is_subscribed as feed_is_subscribed,
+    f.fetch_full_content as feed_fetch_full_content, f.fetch_timeout_seconds as feed_fetch_timeout_seconds,
+    f.folder_id as feed_folder_id
 FROM articles AS a
 INNER JOIN feeds AS f ON a.feed_id = f.id
 WHERE a.is_read = 1 AND f.is_subscribed = 1 AND f.user_id = ?
@@ -38,8 +44,8 @@ FROM articles
 WHERE feed_id = ?;
 
 -- name: CreateArticle :one
-INSERT INTO articles (feed_id, guid, title, url, is_read)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO articles (feed_id, guid, title, url, is_read, published_at, content)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdateArticle :exec
@@ -67,13 +73,140 @@ DELETE FROM articles
 WHERE feed_id = ?;
 
 -- name: CheckArticleExists :one
-SELECT EXISTS(
+SELECT CAST(EXISTS(
     SELECT 1 FROM articles
     WHERE feed_id = ? AND guid = ?
-) as article_exists;
+) AS INTEGER) as article_exists;
 
 -- name: CheckArticleExistsByGUID :one
-SELECT EXISTS(
+SELECT CAST(EXISTS(
     SELECT 1 FROM articles
     WHERE guid = ?
-) as article_exists;
+) AS INTEGER) as article_exists;
+
+-- name: CountUnreadArticles :one
+SELECT COUNT(*)
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?;
+
+-- name: UpdateArticleStarredStatus :exec
+UPDATE articles
+SET is_starred = ?
+WHERE id = ?;
+
+-- name: MarkFeedArticlesReadBefore :exec
+UPDATE articles
+SET is_read = 1
+WHERE feed_id = ? AND published_at <= ?;
+
+-- name: MarkAllArticlesReadBefore :exec
+UPDATE articles
+SET is_read = 1
+WHERE published_at <= sqlc.arg(before) AND feed_id IN (
+    SELECT id FROM feeds WHERE user_id = sqlc.arg(user_id)
+);
+
+-- name: CountArticles :one
+SELECT COUNT(*)
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = ?;
+
+-- name: GetUnreadArticleIDs :many
+SELECT a.id
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
+ORDER BY a.id;
+
+-- name: GetStarredArticleIDs :many
+SELECT a.id
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_starred = 1 AND f.is_subscribed = 1 AND f.user_id = ?
+ORDER BY a.id;
+
+-- name: GetArticlesAfterID :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = sqlc.arg(user_id) AND a.id > sqlc.arg(after_id)
+ORDER BY a.id
+LIMIT sqlc.arg(limit);
+
+-- name: GetArticlesBeforeID :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = sqlc.arg(user_id) AND a.id < sqlc.arg(before_id)
+ORDER BY a.id DESC
+LIMIT sqlc.arg(limit);
+
+-- name: GetArticlesByIDs :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.user_id = sqlc.arg(user_id) AND a.id IN (sqlc.slice(ids))
+ORDER BY a.id;
+
+-- name: GetStreamArticles :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = sqlc.arg(user_id)
+    AND (CAST(sqlc.arg(feed_id) AS INTEGER) = 0 OR a.feed_id = sqlc.arg(feed_id))
+    AND (CAST(sqlc.arg(folder_id) AS INTEGER) = 0 OR f.folder_id = sqlc.arg(folder_id))
+    AND (CAST(sqlc.arg(is_read) AS INTEGER) = -1 OR a.is_read = sqlc.arg(is_read))
+    AND (CAST(sqlc.arg(starred_only) AS INTEGER) = 0 OR a.is_starred = 1)
+    AND a.id > sqlc.arg(min_id) AND a.id < sqlc.arg(max_id)
+    AND a.published_at >= sqlc.arg(published_since) AND a.published_at < sqlc.arg(published_before)
+ORDER BY a.id DESC
+LIMIT sqlc.arg(limit);
+
+-- name: GetStreamArticlesOldestFirst :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = sqlc.arg(user_id)
+    AND (CAST(sqlc.arg(feed_id) AS INTEGER) = 0 OR a.feed_id = sqlc.arg(feed_id))
+    AND (CAST(sqlc.arg(folder_id) AS INTEGER) = 0 OR f.folder_id = sqlc.arg(folder_id))
+    AND (CAST(sqlc.arg(is_read) AS INTEGER) = -1 OR a.is_read = sqlc.arg(is_read))
+    AND (CAST(sqlc.arg(starred_only) AS INTEGER) = 0 OR a.is_starred = 1)
+    AND a.id > sqlc.arg(min_id) AND a.id < sqlc.arg(max_id)
+    AND a.published_at >= sqlc.arg(published_since) AND a.published_at < sqlc.arg(published_before)
+ORDER BY a.id ASC
+LIMIT sqlc.arg(limit);
+
+-- name: GetUnreadCountsByFeed :many
+SELECT a.feed_id, COUNT(*) AS unread_count, CAST(MAX(a.published_at) AS TEXT) AS newest_published_at
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ?
+GROUP BY a.feed_id
+ORDER BY a.feed_id;
+
+-- name: SearchArticles :many
+SELECT a.id, a.feed_id, a.guid, a.title, a.url, a.is_read, a.is_starred, a.published_at, a.content
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+WHERE f.is_subscribed = 1 AND f.user_id = sqlc.arg(user_id)
+    AND LOWER(a.title) LIKE sqlc.arg(pattern)
+ORDER BY a.id DESC
+LIMIT sqlc.arg(limit);
+
+-- name: GetArticleContent :one
+SELECT content
+FROM articles
+WHERE id = ?;
+
+-- name: UpdateArticleContent :exec
+UPDATE articles
+SET content = ?
+WHERE id = ?;
+
+-- name: DeleteArticlesByUser :exec
+DELETE FROM articles
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+);
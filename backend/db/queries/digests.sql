@@ -0,0 +1,86 @@
+-- name: GetDigestSettings :one
+SELECT user_id, email, frequency, min_articles, last_run_at, last_article_id
+FROM digest_settings
+WHERE user_id = ?;
+
+-- name: GetActiveDigestSettings :many
+SELECT s.user_id, s.email, s.frequency, s.min_articles, s.last_run_at, s.last_article_id
+FROM digest_settings AS s
+INNER JOIN users AS u ON s.user_id = u.id
+WHERE s.frequency != 'off' AND u.disabled = 0;
+
+-- name: UpsertDigestSettings :exec
+INSERT INTO digest_settings (user_id, email, frequency, min_articles)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (user_id) DO UPDATE
+SET email = excluded.email, frequency = excluded.frequency, min_articles = excluded.min_articles;
+
+-- name: UpdateDigestRun :exec
+UPDATE digest_settings
+SET last_run_at = ?, last_article_id = ?
+WHERE user_id = ?;
+
+-- name: StartDigestAtLatestArticle :exec
+UPDATE digest_settings
+SET last_article_id = (
+    SELECT COALESCE(MAX(a.id), 0)
+    FROM articles AS a
+    INNER JOIN feeds AS f ON a.feed_id = f.id
+    WHERE f.user_id = sqlc.arg(user_id)
+)
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: GetDigestFeedIDs :many
+SELECT feed_id
+FROM digest_feeds
+WHERE user_id = ?
+ORDER BY feed_id;
+
+-- name: AddDigestFeed :exec
+INSERT INTO digest_feeds (user_id, feed_id)
+VALUES (?, ?);
+
+-- name: DeleteDigestFeeds :exec
+DELETE FROM digest_feeds
+WHERE user_id = ?;
+
+-- name: GetDigestFolderIDs :many
+SELECT folder_id
+FROM digest_folders
+WHERE user_id = ?
+ORDER BY folder_id;
+
+-- name: AddDigestFolder :exec
+INSERT INTO digest_folders (user_id, folder_id)
+VALUES (?, ?);
+
+-- name: DeleteDigestFolders :exec
+DELETE FROM digest_folders
+WHERE user_id = ?;
+
+-- name: DeleteDigestFoldersByFolder :exec
+DELETE FROM digest_folders
+WHERE folder_id = ?;
+
+-- name: GetDigestArticles :many
+SELECT
+    a.id, a.feed_id, a.title, a.url,
+    f.title as feed_title,
+    fo.name as folder_name
+FROM articles AS a
+INNER JOIN feeds AS f ON a.feed_id = f.id
+LEFT JOIN folders AS fo ON f.folder_id = fo.id
+WHERE a.is_read = 0 AND f.is_subscribed = 1 AND f.user_id = ? AND a.id > sqlc.arg(after_id)
+    AND (
+        (
+            NOT EXISTS (SELECT 1 FROM digest_feeds AS d WHERE d.user_id = f.user_id)
+            AND NOT EXISTS (SELECT 1 FROM digest_folders AS d WHERE d.user_id = f.user_id)
+        )
+        OR f.id IN (SELECT d.feed_id FROM digest_feeds AS d WHERE d.user_id = f.user_id)
+        OR f.folder_id IN (SELECT d.folder_id FROM digest_folders AS d WHERE d.user_id = f.user_id)
+    )
+ORDER BY fo.name IS NULL, fo.name, fo.id, f.title, f.id, a.id;
+
+-- name: DeleteDigestSettings :exec
+DELETE FROM digest_settings
+WHERE user_id = ?;
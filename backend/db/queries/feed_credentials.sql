@@ -0,0 +1,26 @@
+-- name: GetFeedCredentials :one
+SELECT data
+FROM feed_credentials
+WHERE feed_id = ?;
+
+-- name: UpsertFeedCredentials :exec
+INSERT INTO feed_credentials (feed_id, data)
+VALUES (?, ?)
+ON CONFLICT (feed_id) DO UPDATE
+SET data = excluded.data;
+
+-- name: DeleteFeedCredentials :exec
+DELETE FROM feed_credentials
+WHERE feed_id = ?;
+
+-- name: CheckFeedCredentialsExist :one
+SELECT CAST(EXISTS(
+    SELECT 1 FROM feed_credentials
+    WHERE feed_id = ?
+) AS INTEGER) as credentials_exist;
+
+-- name: DeleteFeedCredentialsByUser :exec
+DELETE FROM feed_credentials
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+);
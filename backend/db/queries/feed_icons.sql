@@ -0,0 +1,28 @@
+-- name: GetFeedIcon :one
+SELECT *
+FROM feed_icons
+WHERE feed_id = ?;
+
+-- name: GetFeedIconUpdatedAt :one
+SELECT updated_at
+FROM feed_icons
+WHERE feed_id = ? AND length(data) > 0;
+
+-- name: GetFeedIconsByUser :many
+SELECT fi.*
+FROM feed_icons AS fi
+INNER JOIN feeds AS f ON fi.feed_id = f.id
+WHERE f.user_id = ? AND length(fi.data) > 0
+ORDER BY fi.feed_id;
+
+-- name: UpsertFeedIcon :exec
+INSERT INTO feed_icons (feed_id, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT (feed_id) DO UPDATE
+SET data = excluded.data, updated_at = excluded.updated_at;
+
+-- name: DeleteFeedIconsByUser :exec
+DELETE FROM feed_icons
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+);
@@ -0,0 +1,14 @@
+-- name: CreateFeedScraper :exec
+INSERT INTO feed_scrapers (feed_id, item_selector, title_selector, link_selector, date_selector, content_selector)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetFeedScraper :one
+SELECT *
+FROM feed_scrapers
+WHERE feed_id = ?;
+
+-- name: DeleteFeedScrapersByUser :exec
+DELETE FROM feed_scrapers
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+);
@@ -1,10 +1,10 @@
 -- name: GetFeed :one
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE id = ?;
 
 -- name: GetFeeds :many
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE is_subscribed = 1 AND user_id = ?
 ORDER BY id;
@@ -24,16 +24,49 @@ DELETE FROM feeds
 WHERE id = ?;
 
 -- name: GetFeedByURL :one
-SELECT id, url, title, fetched_at, is_subscribed, user_id
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
 FROM feeds
 WHERE url = ? AND user_id = ?;
 
 -- name: GetFeedsToFetch :many
-SELECT id, url, fetched_at, user_id
-FROM feeds
-WHERE is_subscribed = 1;
+SELECT f.id, f.url, f.fetched_at, f.user_id, w.state as websub_state, w.lease_expires_at as websub_lease_expires_at
+FROM feeds AS f
+INNER JOIN users AS u ON f.user_id = u.id
+LEFT JOIN websub_subscriptions AS w ON f.id = w.feed_id
+WHERE f.is_subscribed = 1 AND u.disabled = 0;
 
 -- name: UnsubscribeFeed :exec
 UPDATE feeds
 SET is_subscribed = 0
 WHERE id = ?;
+
+-- name: CheckSubscribedFeedExistsByURL :one
+SELECT CAST(EXISTS(
+    SELECT 1 FROM feeds
+    WHERE url = ? AND user_id = ? AND is_subscribed = 1
+) AS INTEGER) as feed_exists;
+
+-- name: UpdateFeedFetchFullContent :exec
+UPDATE feeds
+SET fetch_full_content = ?
+WHERE id = ?;
+
+-- name: UpdateFeedFetchTimeout :exec
+UPDATE feeds
+SET fetch_timeout_seconds = ?
+WHERE id = ?;
+
+-- name: DeleteFeedsByUser :exec
+DELETE FROM feeds
+WHERE user_id = ?;
+
+-- name: UpdateFeedFolder :exec
+UPDATE feeds
+SET folder_id = ?
+WHERE id = ?;
+
+-- name: GetFeedsByFolder :many
+SELECT id, url, title, fetched_at, is_subscribed, user_id, fetch_full_content, fetch_timeout_seconds, folder_id
+FROM feeds
+WHERE is_subscribed = 1 AND folder_id = ?
+ORDER BY id;
@@ -0,0 +1,38 @@
+-- name: CreateFolder :one
+INSERT INTO folders (user_id, name, created_at)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: GetFolder :one
+SELECT *
+FROM folders
+WHERE id = ?;
+
+-- name: GetFolders :many
+SELECT *
+FROM folders
+WHERE user_id = ?
+ORDER BY name, id;
+
+-- name: GetFolderByName :one
+SELECT *
+FROM folders
+WHERE user_id = ? AND name = ?;
+
+-- name: RenameFolder :exec
+UPDATE folders
+SET name = ?
+WHERE id = ?;
+
+-- name: DeleteFolder :exec
+DELETE FROM folders
+WHERE id = ?;
+
+-- name: ClearFeedsFolder :exec
+UPDATE feeds
+SET folder_id = NULL
+WHERE folder_id = ?;
+
+-- name: DeleteFoldersByUser :exec
+DELETE FROM folders
+WHERE user_id = ?;
@@ -0,0 +1,13 @@
+-- name: CreateLease :execrows
+INSERT INTO leases (name, holder, expires_at)
+VALUES (?, ?, ?)
+ON CONFLICT (name) DO NOTHING;
+
+-- name: ExtendLease :execrows
+UPDATE leases
+SET holder = sqlc.arg(holder), expires_at = sqlc.arg(expires_at)
+WHERE name = sqlc.arg(name) AND (holder = sqlc.arg(holder) OR expires_at < sqlc.arg(now));
+
+-- name: ReleaseLease :exec
+DELETE FROM leases
+WHERE name = ? AND holder = ?;
@@ -0,0 +1,27 @@
+-- name: CreateOutputFeed :one
+INSERT INTO output_feeds (user_id, token, title, source, feed_id, folder_id, query, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetOutputFeeds :many
+SELECT *
+FROM output_feeds
+WHERE user_id = ?
+ORDER BY id;
+
+-- name: GetOutputFeedByToken :one
+SELECT *
+FROM output_feeds
+WHERE token = ?;
+
+-- name: DeleteOutputFeed :execrows
+DELETE FROM output_feeds
+WHERE id = ? AND user_id = ?;
+
+-- name: DeleteOutputFeedsByFolder :exec
+DELETE FROM output_feeds
+WHERE folder_id = ?;
+
+-- name: DeleteOutputFeedsByUser :exec
+DELETE FROM output_feeds
+WHERE user_id = ?;
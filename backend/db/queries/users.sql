@@ -4,11 +4,46 @@ VALUES (?, ?)
 RETURNING *;
 
 -- name: GetUserByUsername :one
-SELECT id, username, password_hash, created_at
+SELECT id, username, password_hash, created_at, disabled
 FROM users
 WHERE username = ?;
 
 -- name: GetUserByID :one
-SELECT id, username, password_hash, created_at
+SELECT id, username, password_hash, created_at, disabled
 FROM users
 WHERE id = ?;
+
+-- name: GetUserByFeverAPIKey :one
+SELECT id, username, password_hash, created_at, disabled
+FROM users
+WHERE fever_api_key = ? AND disabled = 0;
+
+-- name: UpdateUserFeverAPIKey :exec
+UPDATE users
+SET fever_api_key = ?
+WHERE id = ?;
+
+-- name: GetUsers :many
+SELECT id, username, created_at, disabled
+FROM users
+ORDER BY id;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = ?
+WHERE id = ?;
+
+-- name: UpdateUsername :exec
+-- The Fever API key is derived from the username, so it stops matching and is cleared.
+UPDATE users
+SET username = ?, fever_api_key = NULL
+WHERE id = ?;
+
+-- name: UpdateUserDisabled :exec
+UPDATE users
+SET disabled = ?
+WHERE id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
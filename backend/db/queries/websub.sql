@@ -0,0 +1,40 @@
+-- name: GetWebSubSubscription :one
+SELECT feed_id, hub_url, topic_url, secret, state, lease_expires_at, requested_at
+FROM websub_subscriptions
+WHERE feed_id = ?;
+
+-- name: UpsertWebSubSubscription :exec
+INSERT INTO websub_subscriptions (feed_id, hub_url, topic_url, secret, state, requested_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (feed_id) DO UPDATE
+SET hub_url = excluded.hub_url, topic_url = excluded.topic_url, secret = excluded.secret,
+    state = excluded.state, requested_at = excluded.requested_at;
+
+-- name: ActivateWebSubSubscription :exec
+UPDATE websub_subscriptions
+SET state = 'active', lease_expires_at = ?
+WHERE feed_id = ?;
+
+-- name: UpdateWebSubSubscriptionState :exec
+UPDATE websub_subscriptions
+SET state = ?
+WHERE feed_id = ?;
+
+-- name: DeleteWebSubSubscription :exec
+DELETE FROM websub_subscriptions
+WHERE feed_id = ?;
+
+-- name: GetWebSubSubscriptionsToRenew :many
+SELECT w.feed_id, w.hub_url, w.topic_url, w.secret, w.state, w.lease_expires_at, w.requested_at
+FROM websub_subscriptions AS w
+INNER JOIN feeds AS f ON w.feed_id = f.id
+WHERE f.is_subscribed = 1 AND (
+    (w.state = 'active' AND w.lease_expires_at < sqlc.arg(renew_before))
+    OR (w.state = 'pending' AND w.requested_at < sqlc.arg(retry_before))
+);
+
+-- name: DeleteWebSubSubscriptionsByUser :exec
+DELETE FROM websub_subscriptions
+WHERE feed_id IN (
+    SELECT id FROM feeds WHERE user_id = ?
+);
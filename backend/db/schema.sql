@@ -3,7 +3,9 @@ CREATE TABLE IF NOT EXISTS users (
     id            INTEGER PRIMARY KEY AUTOINCREMENT,
     username      TEXT NOT NULL UNIQUE,
     password_hash TEXT NOT NULL,
-    created_at    TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+    created_at    TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    fever_api_key TEXT,
+    disabled      INTEGER NOT NULL DEFAULT 0
 );
 
 -- Feeds
@@ -13,7 +15,19 @@ CREATE TABLE IF NOT EXISTS feeds (
     title         TEXT NOT NULL,
     fetched_at    TEXT NOT NULL,
     is_subscribed INTEGER NOT NULL DEFAULT 1,
-    user_id       INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE
+    user_id       INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    fetch_full_content INTEGER NOT NULL DEFAULT 0,
+    fetch_timeout_seconds INTEGER NOT NULL DEFAULT 0,
+    folder_id     INTEGER
+);
+
+-- Folders grouping the feeds of a user
+CREATE TABLE IF NOT EXISTS folders (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    UNIQUE (user_id, name)
 );
 
 -- Articles
@@ -24,9 +38,104 @@ CREATE TABLE IF NOT EXISTS articles (
     title   TEXT NOT NULL,
     url     TEXT NOT NULL,
     is_read INTEGER NOT NULL DEFAULT 0,
+    is_starred   INTEGER NOT NULL DEFAULT 0,
+    published_at TEXT NOT NULL DEFAULT '',
+    content      TEXT NOT NULL DEFAULT '',
     FOREIGN KEY (feed_id) REFERENCES feeds(id) ON DELETE CASCADE
 );
 
+-- Digest settings (one row per user)
+CREATE TABLE IF NOT EXISTS digest_settings (
+    user_id         INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email           TEXT NOT NULL,
+    frequency       TEXT NOT NULL DEFAULT 'off',
+    min_articles    INTEGER NOT NULL DEFAULT 1,
+    last_run_at     TEXT,
+    last_article_id INTEGER NOT NULL DEFAULT 0
+);
+
+-- Feeds included in the digest. If a user has no rows here, all feeds are included.
+CREATE TABLE IF NOT EXISTS digest_feeds (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feed_id INTEGER NOT NULL REFERENCES feeds(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, feed_id)
+);
+
+-- Folders whose feeds are included in the digest, in addition to digest_feeds
+CREATE TABLE IF NOT EXISTS digest_folders (
+    user_id   INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    folder_id INTEGER NOT NULL REFERENCES folders(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, folder_id)
+);
+
+-- WebSub (PubSubHubbub) push subscriptions
+CREATE TABLE IF NOT EXISTS websub_subscriptions (
+    feed_id          INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    hub_url          TEXT NOT NULL,
+    topic_url        TEXT NOT NULL,
+    secret           TEXT NOT NULL,
+    state            TEXT NOT NULL DEFAULT 'pending',
+    lease_expires_at TEXT,
+    requested_at     TEXT NOT NULL
+);
+
+-- Personal API tokens. Only the SHA-256 hash of the token is stored.
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id      INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name         TEXT NOT NULL,
+    token_hash   TEXT NOT NULL UNIQUE,
+    scope        TEXT NOT NULL,
+    expires_at   TEXT,
+    last_used_at TEXT,
+    created_at   TEXT NOT NULL
+);
+
+-- Feeds generated from a user's articles, served at /output/<token>.{rss,atom,json}.
+-- source is 'starred', 'feed' (articles of feed_id) or 'search' (articles whose title matches query).
+CREATE TABLE IF NOT EXISTS output_feeds (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token      TEXT NOT NULL UNIQUE,
+    title      TEXT NOT NULL,
+    source     TEXT NOT NULL,
+    feed_id    INTEGER REFERENCES feeds(id) ON DELETE CASCADE,
+    query      TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL,
+    folder_id  INTEGER
+);
+
+-- data is a PNG image, or empty if no icon was found.
+CREATE TABLE IF NOT EXISTS feed_icons (
+    feed_id    INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data       BLOB NOT NULL,
+    updated_at TEXT NOT NULL
+);
+
+-- A feed with a scraper is scraped from the page at its URL with these CSS selectors.
+-- Selectors other than item_selector are relative to each item and optional.
+CREATE TABLE IF NOT EXISTS feed_scrapers (
+    feed_id          INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    item_selector    TEXT NOT NULL,
+    title_selector   TEXT NOT NULL DEFAULT '',
+    link_selector    TEXT NOT NULL DEFAULT '',
+    date_selector    TEXT NOT NULL DEFAULT '',
+    content_selector TEXT NOT NULL DEFAULT ''
+);
+
+-- data is the JSON-encoded credentials, encrypted with AES-GCM and base64-encoded.
+CREATE TABLE IF NOT EXISTS feed_credentials (
+    feed_id INTEGER PRIMARY KEY REFERENCES feeds(id) ON DELETE CASCADE,
+    data    TEXT NOT NULL
+);
+
+-- Locks shared by the processes using the database. holder is the process that holds the lease until expires_at.
+CREATE TABLE IF NOT EXISTS leases (
+    name       TEXT PRIMARY KEY,
+    holder     TEXT NOT NULL,
+    expires_at TEXT NOT NULL
+);
+
 -- Indice
 CREATE INDEX IF NOT EXISTS idx_articles_feed_id ON articles(feed_id);
 
@@ -37,3 +146,13 @@ CREATE INDEX IF NOT EXISTS idx_articles_is_read ON articles(is_read);
 CREATE INDEX IF NOT EXISTS idx_articles_guid ON articles(guid);
 
 CREATE INDEX IF NOT EXISTS idx_feeds_user_id ON feeds(user_id);
+
+CREATE INDEX IF NOT EXISTS idx_feeds_folder_id ON feeds(folder_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_fever_api_key ON users(fever_api_key);
+
+CREATE INDEX IF NOT EXISTS idx_articles_is_starred ON articles(is_starred);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_user_id ON api_tokens(user_id);
+
+CREATE INDEX IF NOT EXISTS idx_output_feeds_user_id ON output_feeds(user_id);
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// Store is the storage interface used by the rest of the application. The queries are written in the subset of SQL
+// shared by SQLite and PostgreSQL, so that both backends run the same generated code.
+type Store interface {
+	Querier
+	// InTx calls fn with a Store whose queries run in a transaction, which is committed if fn returns nil and rolled
+	// back otherwise. Calling InTx on such a Store runs fn in the same transaction.
+	InTx(ctx context.Context, fn func(Store) error) error
+}
+
+type store struct {
+	*Queries
+	database *DB
+	wrap     func(DBTX) DBTX
+}
+
+// NewStore returns a Store backed by database. wrap, if not nil, wraps the connection every query runs on, e.g. to
+// instrument the queries.
+func NewStore(database *DB, wrap func(DBTX) DBTX) Store {
+	s := &store{database: database, wrap: wrap}
+	s.Queries = New(s.conn(database.DB))
+	return s
+}
+
+func (s *store) conn(d DBTX) DBTX {
+	if s.database.Dialect != DialectSQLite {
+		d = &rebindDB{db: d, dialect: s.database.Dialect}
+	}
+	if s.wrap != nil {
+		d = s.wrap(d)
+	}
+	return d
+}
+
+func (s *store) InTx(ctx context.Context, fn func(Store) error) error {
+	tx, err := s.database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(&txStore{Queries: New(s.conn(tx))}); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// txStore is a Store whose queries run in a transaction.
+type txStore struct {
+	*Queries
+}
+
+func (s *txStore) InTx(ctx context.Context, fn func(Store) error) error {
+	return fn(s)
+}
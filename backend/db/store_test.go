@@ -0,0 +1,279 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// forEachBackend runs fn against an empty SQLite database, and against an empty schema of the PostgreSQL database at
+// FEEDAKA_TEST_POSTGRES_URL if it is set.
+func forEachBackend(t *testing.T, fn func(t *testing.T, database *DB)) {
+	t.Run("sqlite", func(t *testing.T) {
+		database, err := Open(filepath.Join(t.TempDir(), "feedaka.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { database.Close() })
+		fn(t, database)
+	})
+	t.Run("postgres", func(t *testing.T) {
+		dsn := os.Getenv("FEEDAKA_TEST_POSTGRES_URL")
+		if dsn == "" {
+			t.Skip("FEEDAKA_TEST_POSTGRES_URL is not set")
+		}
+		fn(t, openPostgresSchema(t, dsn))
+	})
+}
+
+// openPostgresSchema creates a schema for the test and opens dsn with it as the search path. The schema is dropped
+// when the test ends.
+func openPostgresSchema(t *testing.T, dsn string) *DB {
+	t.Helper()
+	admin, err := Open(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+	schema := "feedaka_test_" + hex.EncodeToString(suffix)
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("failed to drop schema %s: %v", schema, err)
+		}
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+	database, err := Open(u.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+	return database
+}
+
+func migrate(t *testing.T, database *DB) {
+	t.Helper()
+	if err := RunMigrations(database); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, database *DB) {
+		migrate(t, database)
+		if err := ValidateSchemaVersion(database); err != nil {
+			t.Fatal(err)
+		}
+		// Running them again is a no-op
+		migrate(t, database)
+
+		// Every migration supported by both dialects can be reverted and applied again
+		const target = 15
+		if err := RollbackMigrations(database, target); err != nil {
+			t.Fatal(err)
+		}
+		version, err := getSchemaVersion(database)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != target {
+			t.Fatalf("schema version after rollback = %d, want %d", version, target)
+		}
+		if err := ValidateSchemaVersion(database); err == nil {
+			t.Error("ValidateSchemaVersion succeeded after rollback")
+		}
+
+		migrate(t, database)
+		if err := ValidateSchemaVersion(database); err != nil {
+			t.Fatal(err)
+		}
+		statuses, err := GetMigrationStatus(database)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range statuses {
+			if s.Modified() {
+				t.Errorf("migration %d is reported as modified", s.Version)
+			}
+		}
+	})
+}
+
+// storeFixture is a user with a feed of two articles.
+type storeFixture struct {
+	user     User
+	feed     Feed
+	articles []Article
+}
+
+func newStoreFixture(t *testing.T, ctx context.Context, store Store) storeFixture {
+	t.Helper()
+	user, err := store.CreateUser(ctx, CreateUserParams{Username: "alice", PasswordHash: "hash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed, err := store.CreateFeed(ctx, CreateFeedParams{
+		Url:       "https://example.com/feed.xml",
+		Title:     "Example",
+		FetchedAt: "2026-01-01T00:00:00Z",
+		UserID:    user.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := storeFixture{user: user, feed: feed}
+	for _, title := range []string{"Hello World", "Goodbye"} {
+		article, err := store.CreateArticle(ctx, CreateArticleParams{
+			FeedID:      feed.ID,
+			Guid:        "guid-" + title,
+			Title:       title,
+			Url:         "https://example.com/" + title,
+			PublishedAt: "2026-01-01T00:00:00Z",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.articles = append(f.articles, article)
+	}
+	return f
+}
+
+func TestStoreQueries(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, database *DB) {
+		ctx := context.Background()
+		migrate(t, database)
+		store := NewStore(database, nil)
+		f := newStoreFixture(t, ctx, store)
+
+		t.Run("exists", func(t *testing.T) {
+			for _, tt := range []struct {
+				guid string
+				want int64
+			}{
+				{"guid-Hello World", 1},
+				{"guid-missing", 0},
+			} {
+				got, err := store.CheckArticleExists(ctx, CheckArticleExistsParams{FeedID: f.feed.ID, Guid: tt.guid})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.want {
+					t.Errorf("CheckArticleExists(%q) = %d, want %d", tt.guid, got, tt.want)
+				}
+			}
+			got, err := store.CheckSubscribedFeedExistsByURL(ctx, CheckSubscribedFeedExistsByURLParams{
+				Url:    f.feed.Url,
+				UserID: f.user.ID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != 1 {
+				t.Errorf("CheckSubscribedFeedExistsByURL = %d, want 1", got)
+			}
+		})
+
+		t.Run("search is case-insensitive", func(t *testing.T) {
+			articles, err := store.SearchArticles(ctx, SearchArticlesParams{
+				UserID:  f.user.ID,
+				Pattern: "%hello%",
+				Limit:   10,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(articles) != 1 || articles[0].ID != f.articles[0].ID {
+				t.Errorf("SearchArticles(%%hello%%) = %+v, want %q only", articles, f.articles[0].Title)
+			}
+		})
+
+		t.Run("optional filters", func(t *testing.T) {
+			params := GetStreamArticlesParams{
+				UserID:          f.user.ID,
+				IsRead:          -1,
+				MaxID:           1 << 62,
+				PublishedBefore: "9999",
+				Limit:           10,
+			}
+			articles, err := store.GetStreamArticles(ctx, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(articles) != 2 || articles[0].ID != f.articles[1].ID {
+				t.Errorf("GetStreamArticles without filters = %+v, want both articles newest first", articles)
+			}
+
+			params.FeedID = f.feed.ID + 1
+			articles, err = store.GetStreamArticles(ctx, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(articles) != 0 {
+				t.Errorf("GetStreamArticles of another feed = %+v, want none", articles)
+			}
+		})
+
+		t.Run("aggregates", func(t *testing.T) {
+			counts, err := store.GetUnreadCountsByFeed(ctx, f.user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(counts) != 1 || counts[0].UnreadCount != 2 || counts[0].NewestPublishedAt != "2026-01-01T00:00:00Z" {
+				t.Errorf("GetUnreadCountsByFeed = %+v", counts)
+			}
+		})
+	})
+}
+
+func TestStoreInTx(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, database *DB) {
+		ctx := context.Background()
+		migrate(t, database)
+		store := NewStore(database, nil)
+
+		errRollback := errors.New("rollback")
+		err := store.InTx(ctx, func(tx Store) error {
+			if _, err := tx.CreateUser(ctx, CreateUserParams{Username: "bob", PasswordHash: "hash"}); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		if !errors.Is(err, errRollback) {
+			t.Fatalf("InTx = %v, want %v", err, errRollback)
+		}
+		if _, err := store.GetUserByUsername(ctx, "bob"); err == nil {
+			t.Error("the user created in a rolled back transaction exists")
+		}
+
+		err = store.InTx(ctx, func(tx Store) error {
+			// A nested InTx runs in the same transaction
+			return tx.InTx(ctx, func(tx Store) error {
+				_, err := tx.CreateUser(ctx, CreateUserParams{Username: "bob", PasswordHash: "hash"})
+				return err
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.GetUserByUsername(ctx, "bob"); err != nil {
+			t.Errorf("the user created in a committed transaction does not exist: %v", err)
+		}
+	})
+}
@@ -7,12 +7,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (username, password_hash)
 VALUES (?, ?)
-RETURNING id, username, password_hash, created_at
+RETURNING id, username, password_hash, created_at, fever_api_key, disabled
 `
 
 type CreateUserParams struct {
@@ -28,42 +29,205 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Username,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.FeverApiKey,
+		&i.Disabled,
+	)
+	return i, err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
+const getUserByFeverAPIKey = `-- name: GetUserByFeverAPIKey :one
+SELECT id, username, password_hash, created_at, disabled
+FROM users
+WHERE fever_api_key = ? AND disabled = 0
+`
+
+type GetUserByFeverAPIKeyRow struct {
+	ID           int64
+	Username     string
+	PasswordHash string
+	CreatedAt    string
+	Disabled     int64
+}
+
+func (q *Queries) GetUserByFeverAPIKey(ctx context.Context, feverApiKey sql.NullString) (GetUserByFeverAPIKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserByFeverAPIKey, feverApiKey)
+	var i GetUserByFeverAPIKeyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.Disabled,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, password_hash, created_at
+SELECT id, username, password_hash, created_at, disabled
 FROM users
 WHERE id = ?
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+type GetUserByIDRow struct {
+	ID           int64
+	Username     string
+	PasswordHash string
+	CreatedAt    string
+	Disabled     int64
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (GetUserByIDRow, error) {
 	row := q.db.QueryRowContext(ctx, getUserByID, id)
-	var i User
+	var i GetUserByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.Disabled,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash, created_at
+SELECT id, username, password_hash, created_at, disabled
 FROM users
 WHERE username = ?
 `
 
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
+type GetUserByUsernameRow struct {
+	ID           int64
+	Username     string
+	PasswordHash string
+	CreatedAt    string
+	Disabled     int64
+}
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
 	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
-	var i User
+	var i GetUserByUsernameRow
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.Disabled,
 	)
 	return i, err
 }
+
+const getUsers = `-- name: GetUsers :many
+SELECT id, username, created_at, disabled
+FROM users
+ORDER BY id
+`
+
+type GetUsersRow struct {
+	ID        int64
+	Username  string
+	CreatedAt string
+	Disabled  int64
+}
+
+func (q *Queries) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetUsersRow{}
+	for rows.Next() {
+		var i GetUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.CreatedAt,
+			&i.Disabled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUserDisabled = `-- name: UpdateUserDisabled :exec
+UPDATE users
+SET disabled = ?
+WHERE id = ?
+`
+
+type UpdateUserDisabledParams struct {
+	Disabled int64
+	ID       int64
+}
+
+func (q *Queries) UpdateUserDisabled(ctx context.Context, arg UpdateUserDisabledParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserDisabled, arg.Disabled, arg.ID)
+	return err
+}
+
+const updateUserFeverAPIKey = `-- name: UpdateUserFeverAPIKey :exec
+UPDATE users
+SET fever_api_key = ?
+WHERE id = ?
+`
+
+type UpdateUserFeverAPIKeyParams struct {
+	FeverApiKey sql.NullString
+	ID          int64
+}
+
+func (q *Queries) UpdateUserFeverAPIKey(ctx context.Context, arg UpdateUserFeverAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserFeverAPIKey, arg.FeverApiKey, arg.ID)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = ?
+WHERE id = ?
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string
+	ID           int64
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.PasswordHash, arg.ID)
+	return err
+}
+
+const updateUsername = `-- name: UpdateUsername :exec
+UPDATE users
+SET username = ?, fever_api_key = NULL
+WHERE id = ?
+`
+
+type UpdateUsernameParams struct {
+	Username string
+	ID       int64
+}
+
+// The Fever API key is derived from the username, so it stops matching and is cleared.
+func (q *Queries) UpdateUsername(ctx context.Context, arg UpdateUsernameParams) error {
+	_, err := q.db.ExecContext(ctx, updateUsername, arg.Username, arg.ID)
+	return err
+}
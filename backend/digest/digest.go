@@ -0,0 +1,223 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"log/slog"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/mail"
+)
+
+const (
+	FrequencyOff    = "off"
+	FrequencyDaily  = "daily"
+	FrequencyWeekly = "weekly"
+)
+
+// Maximum number of articles listed per feed. The rest are summarized as "...and N more".
+const maxArticlesPerFeed = 20
+
+// Digests are checked hourly, so allow some slack to avoid drifting by one tick every period.
+const scheduleSlack = 10 * time.Minute
+
+var (
+	//go:embed templates/*
+	templatesFS embed.FS
+
+	htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templatesFS, "templates/digest.html.tmpl"))
+	textTemplate = texttemplate.Must(texttemplate.ParseFS(templatesFS, "templates/digest.txt.tmpl"))
+)
+
+type Article struct {
+	Title string
+	URL   string
+}
+
+type FeedGroup struct {
+	Title    string
+	Articles []Article
+	More     int
+}
+
+// FolderGroup is the feeds of a folder. Name is empty if the digest has no folders at all.
+type FolderGroup struct {
+	Name  string
+	Feeds []FeedGroup
+}
+
+// Digest is the data passed to the digest templates.
+type Digest struct {
+	Subject   string
+	Username  string
+	Frequency string
+	Count     int
+	Folders   []FolderGroup
+}
+
+func IsValidFrequency(frequency string) bool {
+	switch frequency {
+	case FrequencyOff, FrequencyDaily, FrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+func interval(frequency string) time.Duration {
+	switch frequency {
+	case FrequencyDaily:
+		return 24 * time.Hour
+	case FrequencyWeekly:
+		return 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+func isDue(s db.DigestSetting, now time.Time) (bool, error) {
+	d := interval(s.Frequency)
+	if d == 0 {
+		return false, nil
+	}
+	if !s.LastRunAt.Valid {
+		return true, nil
+	}
+	lastRunAt, err := time.Parse(time.RFC3339, s.LastRunAt.String)
+	if err != nil {
+		return false, err
+	}
+	return now.Sub(lastRunAt) >= d-scheduleSlack, nil
+}
+
+// SendDue sends digests to every user whose schedule has come around.
+func SendDue(ctx context.Context, queries db.Store, sender *mail.Sender) error {
+	settings, err := queries.GetActiveDigestSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var result *multierror.Error
+	for _, s := range settings {
+		due, err := isDue(s, now)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("user %d: %w", s.UserID, err))
+			continue
+		}
+		if !due {
+			continue
+		}
+		if err := send(ctx, queries, sender, s, now); err != nil {
+			result = multierror.Append(result, fmt.Errorf("user %d: %w", s.UserID, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func send(ctx context.Context, queries db.Store, sender *mail.Sender, s db.DigestSetting, now time.Time) error {
+	rows, err := queries.GetDigestArticles(ctx, db.GetDigestArticlesParams{
+		UserID:  s.UserID,
+		AfterID: s.LastArticleID,
+	})
+	if err != nil {
+		return err
+	}
+
+	lastArticleID := s.LastArticleID
+	if int64(len(rows)) >= s.MinArticles && len(rows) > 0 {
+		user, err := queries.GetUserByID(ctx, s.UserID)
+		if err != nil {
+			return err
+		}
+
+		d := build(user.Username, s.Frequency, rows)
+		msg, err := render(s.Email, d)
+		if err != nil {
+			return err
+		}
+		if err := sender.Send(msg); err != nil {
+			return fmt.Errorf("failed to send digest: %w", err)
+		}
+		slog.InfoContext(ctx, "Sent digest", "frequency", s.Frequency, "articles", d.Count, "user_id", s.UserID)
+
+		for _, row := range rows {
+			lastArticleID = max(lastArticleID, row.ID)
+		}
+	}
+	// When there are fewer new articles than the threshold, they are carried over to the next digest.
+
+	return queries.UpdateDigestRun(ctx, db.UpdateDigestRunParams{
+		LastRunAt:     sql.NullString{String: now.Format(time.RFC3339), Valid: true},
+		LastArticleID: lastArticleID,
+		UserID:        s.UserID,
+	})
+}
+
+func build(username, frequency string, rows []db.GetDigestArticlesRow) *Digest {
+	d := &Digest{
+		Username:  username,
+		Frequency: frequency,
+		Count:     len(rows),
+	}
+	if d.Count == 1 {
+		d.Subject = fmt.Sprintf("[feedaka] 1 new article (%s digest)", frequency)
+	} else {
+		d.Subject = fmt.Sprintf("[feedaka] %d new articles (%s digest)", d.Count, frequency)
+	}
+
+	// Rows are ordered by folder, with feeds in no folder last, and then by feed
+	var folder *FolderGroup
+	var group *FeedGroup
+	var folderName sql.NullString
+	var feedID int64
+	for _, row := range rows {
+		if folder == nil || row.FolderName != folderName {
+			name := row.FolderName.String
+			if !row.FolderName.Valid && folder != nil {
+				name = "Other feeds"
+			}
+			d.Folders = append(d.Folders, FolderGroup{Name: name})
+			folder = &d.Folders[len(d.Folders)-1]
+			folderName = row.FolderName
+			group = nil
+		}
+		if group == nil || row.FeedID != feedID {
+			folder.Feeds = append(folder.Feeds, FeedGroup{Title: row.FeedTitle})
+			group = &folder.Feeds[len(folder.Feeds)-1]
+			feedID = row.FeedID
+		}
+		if len(group.Articles) >= maxArticlesPerFeed {
+			group.More++
+			continue
+		}
+		title := row.Title
+		if title == "" {
+			title = row.Url
+		}
+		group.Articles = append(group.Articles, Article{Title: title, URL: row.Url})
+	}
+	return d
+}
+
+func render(to string, d *Digest) (*mail.Message, error) {
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, d); err != nil {
+		return nil, fmt.Errorf("failed to render text digest: %w", err)
+	}
+	if err := htmlTemplate.Execute(&html, d); err != nil {
+		return nil, fmt.Errorf("failed to render HTML digest: %w", err)
+	}
+	return &mail.Message{
+		To:      to,
+		Subject: d.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,299 @@
+package favicon
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mmcdole/gofeed"
+	_ "golang.org/x/image/bmp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	appcontext "undef.ninja/x/feedaka/context"
+	"undef.ninja/x/feedaka/db"
+)
+
+// Icons are normalized to PNG images of at most this width and height
+const iconSize = 32
+
+// Maximum size of a downloaded icon or site page
+const maxDownloadSize = 1 << 20
+
+// Icons wider or taller than this are not decoded. A small compressed file can declare a huge image, and decoding
+// allocates memory for every pixel.
+const maxImageSize = 1024
+
+var errImageTooLarge = errors.New("image too large")
+
+// Icons are discovered again after this interval, also when none was found
+const refreshInterval = 7 * 24 * time.Hour
+
+// Timeout of each request made to find an icon
+const requestTimeout = 10 * time.Second
+
+// URL returns the path serving the icon of the feed. updatedAt makes the URL change when the icon does.
+func URL(feedID int64, updatedAt string) string {
+	t, _ := time.Parse(time.RFC3339, updatedAt)
+	return fmt.Sprintf("/icons/%d?v=%d", feedID, t.Unix())
+}
+
+// Refresh discovers the icon of the feed's site and stores it, unless it was discovered recently.
+// If no icon is found, an empty icon is stored so that discovery is not retried on every fetch.
+// Feeds that had an icon keep it.
+func Refresh(ctx context.Context, queries db.Store, client *http.Client, feedID int64, feedURL string, f *gofeed.Feed) error {
+	icon, err := queries.GetFeedIcon(ctx, feedID)
+	if err == nil {
+		updatedAt, err := time.Parse(time.RFC3339, icon.UpdatedAt)
+		if err == nil && time.Since(updatedAt) < refreshInterval {
+			return nil
+		}
+	} else if err != sql.ErrNoRows {
+		return fmt.Errorf("failed to query feed icon: %w", err)
+	}
+
+	data, err := discover(ctx, client, feedURL, f)
+	if err != nil {
+		slog.InfoContext(ctx, "Failed to find icon", "error", err)
+		// Keep the icon found previously, if any. A nil slice would be stored as NULL.
+		data = append([]byte{}, icon.Data...)
+	}
+	err = queries.UpsertFeedIcon(ctx, db.UpsertFeedIconParams{
+		FeedID:    feedID,
+		Data:      data,
+		UpdatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store feed icon: %w", err)
+	}
+	return nil
+}
+
+// discover tries the feed's <image>, the <link rel="icon"> elements of the site's home page and /favicon.ico in order,
+// and returns the first one that can be decoded as a normalized PNG.
+func discover(ctx context.Context, client *http.Client, feedURL string, f *gofeed.Feed) ([]byte, error) {
+	base, err := url.Parse(feedURL)
+	if err != nil {
+		return nil, err
+	}
+	site := base
+	if f.Link != "" {
+		if u, err := url.Parse(f.Link); err == nil {
+			site = base.ResolveReference(u)
+		}
+	}
+
+	var candidates []string
+	if f.Image != nil && f.Image.URL != "" {
+		candidates = append(candidates, resolve(base, f.Image.URL))
+	}
+	candidates = append(candidates, linkedIcons(ctx, client, site)...)
+	candidates = append(candidates, site.ResolveReference(&url.URL{Path: "/favicon.ico"}).String())
+
+	lastErr := fmt.Errorf("no icon candidates")
+	for _, candidate := range candidates {
+		data, err := fetchIcon(ctx, client, candidate)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return data, nil
+	}
+	return nil, lastErr
+}
+
+// linkedIcons returns the URLs of the icons the page at site declares with <link rel="icon">.
+func linkedIcons(ctx context.Context, client *http.Client, site *url.URL) []string {
+	body, err := download(ctx, client, site.String())
+	if err != nil {
+		return nil
+	}
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	var icons, touchIcons []string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Link {
+			var rel, href string
+			for _, attr := range n.Attr {
+				switch attr.Key {
+				case "rel":
+					rel = strings.ToLower(attr.Val)
+				case "href":
+					href = attr.Val
+				}
+			}
+			if href != "" {
+				for _, r := range strings.Fields(rel) {
+					if r == "icon" {
+						icons = append(icons, resolve(site, href))
+						break
+					}
+					if r == "apple-touch-icon" {
+						touchIcons = append(touchIcons, resolve(site, href))
+						break
+					}
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	// Touch icons are larger, so they are only used if there is no plain icon
+	return append(icons, touchIcons...)
+}
+
+func fetchIcon(ctx context.Context, client *http.Client, iconURL string) ([]byte, error) {
+	data, err := download(ctx, client, iconURL)
+	if err != nil {
+		return nil, err
+	}
+	img, err := decodeImage(data)
+	if err != nil && !errors.Is(err, errImageTooLarge) {
+		img, err = decodeICO(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode icon %s: %w", iconURL, err)
+	}
+	return normalize(img)
+}
+
+// decodeImage decodes data in any registered format after checking its dimensions.
+func decodeImage(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width > maxImageSize || cfg.Height > maxImageSize {
+		return nil, fmt.Errorf("%w: %dx%d", errImageTooLarge, cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+func download(ctx context.Context, client *http.Client, rawURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", rawURL, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	if len(data) > maxDownloadSize {
+		return nil, fmt.Errorf("failed to fetch %s: too large", rawURL)
+	}
+	return data, nil
+}
+
+// normalize scales img down to fit in iconSize x iconSize, keeping its aspect ratio, and encodes it as PNG.
+func normalize(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	if w > iconSize || h > iconSize {
+		if w >= h {
+			w, h = iconSize, max(1, h*iconSize/w)
+		} else {
+			w, h = max(1, w*iconSize/h), iconSize
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func resolve(base *url.URL, ref string) string {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+type Handler struct {
+	queries db.Store
+}
+
+func NewHandler(queries db.Store) *Handler {
+	return &Handler{
+		queries: queries,
+	}
+}
+
+// Handle serves /icons/:feedId to the owner of the feed.
+func (h *Handler) Handle(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := appcontext.GetUserID(ctx)
+	if !ok {
+		return echo.ErrUnauthorized
+	}
+	feedID, err := strconv.ParseInt(c.Param("feedId"), 10, 64)
+	if err != nil {
+		return echo.ErrNotFound
+	}
+
+	f, err := h.queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return echo.ErrNotFound
+		}
+		return err
+	}
+	if f.UserID != userID {
+		return echo.ErrNotFound
+	}
+	icon, err := h.queries.GetFeedIcon(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return echo.ErrNotFound
+		}
+		return err
+	}
+	if len(icon.Data) == 0 {
+		return echo.ErrNotFound
+	}
+
+	// The URL changes when the icon does
+	c.Response().Header().Set("Cache-Control", "private, max-age=604800")
+	return c.Blob(http.StatusOK, "image/png", icon.Data)
+}
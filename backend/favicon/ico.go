@@ -0,0 +1,110 @@
+package favicon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+)
+
+var errUnsupportedICO = errors.New("unsupported ICO image")
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// decodeICO decodes the largest image in a Windows .ico file. Entries are either PNG images or
+// 24/32-bit bitmaps without a file header, the format used by virtually all favicons.
+func decodeICO(data []byte) (image.Image, error) {
+	if len(data) < 6 || binary.LittleEndian.Uint16(data[0:]) != 0 || binary.LittleEndian.Uint16(data[2:]) != 1 {
+		return nil, errUnsupportedICO
+	}
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+
+	var best []byte
+	bestWidth := 0
+	for i := range count {
+		if len(data) < 6+16*(i+1) {
+			return nil, errUnsupportedICO
+		}
+		entry := data[6+16*i:]
+		// A width of 0 means 256
+		width := int(entry[0])
+		if width == 0 {
+			width = 256
+		}
+		size := int(binary.LittleEndian.Uint32(entry[8:]))
+		offset := int(binary.LittleEndian.Uint32(entry[12:]))
+		if offset < 0 || size < 0 || offset+size > len(data) {
+			continue
+		}
+		if width > bestWidth {
+			best = data[offset : offset+size]
+			bestWidth = width
+		}
+	}
+	if best == nil {
+		return nil, errUnsupportedICO
+	}
+
+	if bytes.HasPrefix(best, pngSignature) {
+		return decodeImage(best)
+	}
+	return decodeDIB(best)
+}
+
+// decodeDIB decodes a bitmap stored in an ICO entry: a BITMAPINFOHEADER followed by the bottom-up XOR (color) bitmap
+// and the 1-bit AND (transparency) mask. The height in the header counts both bitmaps.
+func decodeDIB(data []byte) (image.Image, error) {
+	if len(data) < 40 {
+		return nil, errUnsupportedICO
+	}
+	headerSize := int(binary.LittleEndian.Uint32(data[0:]))
+	width := int(int32(binary.LittleEndian.Uint32(data[4:])))
+	height := int(int32(binary.LittleEndian.Uint32(data[8:]))) / 2
+	bpp := int(binary.LittleEndian.Uint16(data[14:]))
+	compression := binary.LittleEndian.Uint32(data[16:])
+	if headerSize < 40 || headerSize > len(data) || width <= 0 || width > 256 || height <= 0 || height > 256 || compression != 0 || (bpp != 24 && bpp != 32) {
+		return nil, errUnsupportedICO
+	}
+
+	// Rows are padded to 4 bytes
+	stride := (width*bpp/8 + 3) &^ 3
+	maskStride := ((width+7)/8 + 3) &^ 3
+	pixels := data[headerSize:]
+	if len(pixels) < stride*height {
+		return nil, errUnsupportedICO
+	}
+	mask := pixels[stride*height:]
+	hasMask := len(mask) >= maskStride*height
+
+	masked := func(x, y int) bool {
+		return hasMask && mask[(height-1-y)*maskStride+x/8]&(0x80>>(x%8)) != 0
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	hasAlpha := false
+	for y := range height {
+		row := pixels[(height-1-y)*stride:]
+		for x := range width {
+			if bpp == 32 {
+				p := row[x*4:]
+				img.SetNRGBA(x, y, color.NRGBA{R: p[2], G: p[1], B: p[0], A: p[3]})
+				hasAlpha = hasAlpha || p[3] != 0
+			} else {
+				p := row[x*3:]
+				img.SetNRGBA(x, y, color.NRGBA{R: p[2], G: p[1], B: p[0]})
+			}
+		}
+	}
+	// Without an alpha channel, transparency comes from the mask
+	if !hasAlpha {
+		for y := range height {
+			for x := range width {
+				if !masked(x, y) {
+					img.Pix[img.PixOffset(x, y)+3] = 0xff
+				}
+			}
+		}
+	}
+	return img, nil
+}
@@ -0,0 +1,51 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	neturl "net/url"
+
+	readability "github.com/go-shiori/go-readability"
+	"github.com/mmcdole/gofeed"
+
+	"undef.ninja/x/feedaka/sanitize"
+)
+
+// itemContent returns the content of the item carried by the feed itself.
+func itemContent(item *gofeed.Item) string {
+	if item.Content != "" {
+		return item.Content
+	}
+	return item.Description
+}
+
+// FetchFullContent downloads the article page at url and extracts its main content as sanitized HTML.
+func (ft *Fetcher) FetchFullContent(ctx context.Context, url string) (string, error) {
+	// Credentials of the feed are not sent to article pages, which may be on other hosts
+	page, finalURL, err := ft.fetchPage(ctx, url, RequestOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	// Relative links are resolved against the final URL after redirects
+	article, err := readability.FromReader(bytes.NewReader(page), finalURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract content of %s: %w", url, err)
+	}
+	return sanitize.HTML(article.Content, finalURL.String()), nil
+}
+
+// fetchPage downloads the HTML page at url. It returns the page and its final URL after redirects.
+func (ft *Fetcher) fetchPage(ctx context.Context, url string, opts RequestOptions) ([]byte, *neturl.URL, error) {
+	page, finalURL, contentType, err := ft.get(ctx, url, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, nil, fmt.Errorf("failed to fetch %s: not an HTML page (%s)", url, mediaType)
+	}
+	return page, finalURL, nil
+}
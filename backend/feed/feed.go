@@ -1,45 +1,253 @@
 package feed
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
 	"time"
 
 	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/atom"
+	ext "github.com/mmcdole/gofeed/extensions"
 
+	"undef.ninja/x/feedaka/credential"
 	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/favicon"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/metrics"
+	"undef.ninja/x/feedaka/sanitize"
 )
 
-func Fetch(ctx context.Context, url string) (*gofeed.Feed, error) {
+// atomTranslator keeps the <link rel="hub"> and <link rel="self"> elements of Atom feeds,
+// which the default translator drops, as "atom" extensions like the RSS translator does.
+type atomTranslator struct {
+	gofeed.DefaultAtomTranslator
+}
+
+func (t *atomTranslator) Translate(feed interface{}) (*gofeed.Feed, error) {
+	result, err := t.DefaultAtomTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	af, ok := feed.(*atom.Feed)
+	if !ok {
+		return result, nil
+	}
+	for _, l := range af.Links {
+		if l.Rel != "hub" && l.Rel != "self" {
+			continue
+		}
+		if result.Extensions == nil {
+			result.Extensions = ext.Extensions{}
+		}
+		if result.Extensions["atom"] == nil {
+			result.Extensions["atom"] = map[string][]ext.Extension{}
+		}
+		result.Extensions["atom"]["link"] = append(result.Extensions["atom"]["link"], ext.Extension{
+			Name:  "link",
+			Attrs: map[string]string{"rel": l.Rel, "href": l.Href},
+		})
+	}
+	return result, nil
+}
+
+func newParser() *gofeed.Parser {
 	fp := gofeed.NewParser()
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-	feed, err := fp.ParseURLWithContext(url, ctx)
+	fp.AtomTranslator = &atomTranslator{}
+	return fp
+}
+
+// Parse parses a feed document, e.g. content pushed by a WebSub hub.
+func Parse(r io.Reader) (*gofeed.Feed, error) {
+	return newParser().Parse(r)
+}
+
+// Links returns the hrefs of the feed-level <link> elements with the given rel, e.g. "hub" or "self".
+func Links(f *gofeed.Feed, rel string) []string {
+	var links []string
+	for _, key := range []string{"atom", "atom10", "atom03"} {
+		for _, l := range f.Extensions[key]["link"] {
+			if l.Attrs["rel"] == rel && l.Attrs["href"] != "" {
+				links = append(links, l.Attrs["href"])
+			}
+		}
+	}
+	return links
+}
+
+// Fetch fetches and parses the feed at url.
+func (ft *Fetcher) Fetch(ctx context.Context, url string, opts RequestOptions) (*gofeed.Feed, error) {
+	body, _, _, err := ft.get(ctx, url, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+		return nil, err
+	}
+	feed, err := newParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", url, err)
 	}
 	return feed, nil
 }
 
-func Sync(ctx context.Context, queries *db.Queries, feedID int64, f *gofeed.Feed) error {
-	err := queries.UpdateFeedMetadata(ctx, db.UpdateFeedMetadataParams{
+var ErrAlreadySubscribed = errors.New("already subscribed to this feed")
+
+// AddOptions are the optional settings of a new feed.
+type AddOptions struct {
+	// Selectors make the feed a scraped feed, whose page is scraped instead of parsed as a feed
+	Selectors *Selectors
+	// Credentials are sent with every request for the feed. They are saved to CredentialStore.
+	Credentials     *credential.Credentials
+	CredentialStore *credential.Store
+}
+
+// Add subscribes the user to the feed at url: it fetches the feed, stores it, syncs its articles and finds its icon.
+// It returns the new feed, the fetched document and the articles that were created.
+func (ft *Fetcher) Add(ctx context.Context, queries db.Store, userID int64, url string, opts AddOptions) (db.Feed, *gofeed.Feed, []db.Article, error) {
+	if opts.Selectors != nil {
+		if err := opts.Selectors.Validate(); err != nil {
+			return db.Feed{}, nil, nil, err
+		}
+	}
+	if !opts.Credentials.IsEmpty() {
+		if err := opts.Credentials.Validate(); err != nil {
+			return db.Feed{}, nil, nil, err
+		}
+	}
+
+	exists, err := queries.CheckSubscribedFeedExistsByURL(ctx, db.CheckSubscribedFeedExistsByURLParams{
+		Url:    url,
+		UserID: userID,
+	})
+	if err != nil {
+		return db.Feed{}, nil, nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+	if exists == 1 {
+		return db.Feed{}, nil, nil, ErrAlreadySubscribed
+	}
+
+	// Fetch the feed to get its title
+	var f *gofeed.Feed
+	reqOpts := RequestOptions{Credentials: opts.Credentials}
+	if opts.Selectors == nil {
+		f, err = ft.Fetch(ctx, url, reqOpts)
+	} else {
+		f, err = ft.Scrape(ctx, url, *opts.Selectors, reqOpts)
+	}
+	if err != nil {
+		return db.Feed{}, nil, nil, fmt.Errorf("failed to parse feed: %w", err)
+	}
+
+	// Store the feed and its articles together, so that a failure leaves no feed behind
+	var dbFeed db.Feed
+	var added []db.Article
+	err = queries.InTx(ctx, func(qtx db.Store) error {
+		var err error
+		dbFeed, err = qtx.CreateFeed(ctx, db.CreateFeedParams{
+			Url:       url,
+			Title:     f.Title,
+			FetchedAt: time.Now().UTC().Format(time.RFC3339),
+			UserID:    userID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert feed: %w", err)
+		}
+		if opts.Selectors != nil {
+			err = qtx.CreateFeedScraper(ctx, db.CreateFeedScraperParams{
+				FeedID:          dbFeed.ID,
+				ItemSelector:    opts.Selectors.Item,
+				TitleSelector:   opts.Selectors.Title,
+				LinkSelector:    opts.Selectors.Link,
+				DateSelector:    opts.Selectors.Date,
+				ContentSelector: opts.Selectors.Content,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to insert feed scraper: %w", err)
+			}
+		}
+		if !opts.Credentials.IsEmpty() {
+			if err := opts.CredentialStore.With(qtx).Set(ctx, dbFeed.ID, opts.Credentials); err != nil {
+				return err
+			}
+		}
+
+		// Sync articles from the feed. A new feed does not fetch full content, so no request is made here.
+		added, err = ft.Sync(ctx, qtx, dbFeed.ID, f)
+		if err != nil {
+			return fmt.Errorf("failed to sync articles: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.Feed{}, nil, nil, err
+	}
+	ctx = logging.With(ctx, "feed_id", dbFeed.ID, "feed_url", logging.URL(url))
+
+	err = favicon.Refresh(ctx, queries, ft.publicClient, dbFeed.ID, url, f)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to refresh icon", "error", err)
+	}
+	return dbFeed, f, added, nil
+}
+
+// itemBaseURL returns the URL that relative URLs in the item content are resolved against:
+// the item link, which itself may be relative to the site link or the feed URL.
+func itemBaseURL(feedURL string, f *gofeed.Feed, item *gofeed.Item) string {
+	base, err := url.Parse(feedURL)
+	if err != nil {
+		return ""
+	}
+	for _, ref := range []string{f.Link, item.Link} {
+		if ref == "" {
+			continue
+		}
+		if u, err := url.Parse(ref); err == nil {
+			base = base.ResolveReference(u)
+		}
+	}
+	return base.String()
+}
+
+// publishedAt returns the publication time of the item, falling back to the current time.
+func publishedAt(item *gofeed.Item) time.Time {
+	if item.PublishedParsed != nil {
+		return item.PublishedParsed.UTC()
+	}
+	if item.UpdatedParsed != nil {
+		return item.UpdatedParsed.UTC()
+	}
+	return time.Now().UTC()
+}
+
+// Sync updates the feed metadata and stores its new articles. It returns the articles that were created.
+// If the feed has fetch_full_content set, the content of new articles is extracted from their pages.
+func (ft *Fetcher) Sync(ctx context.Context, queries db.Store, feedID int64, f *gofeed.Feed) ([]db.Article, error) {
+	dbFeed, err := queries.GetFeed(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = queries.UpdateFeedMetadata(ctx, db.UpdateFeedMetadataParams{
 		Title:     f.Title,
 		FetchedAt: time.Now().UTC().Format(time.RFC3339),
 		ID:        feedID,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	guids, err := queries.GetArticleGUIDsByFeed(ctx, feedID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	existingFeedGUIDs := make(map[string]bool, len(guids))
 	for _, guid := range guids {
 		existingFeedGUIDs[guid] = true
 	}
 
+	var added []db.Article
 	for _, item := range f.Items {
 		if existingFeedGUIDs[item.GUID] {
 			err := queries.UpdateArticle(ctx, db.UpdateArticleParams{
@@ -49,27 +257,41 @@ func Sync(ctx context.Context, queries *db.Queries, feedID int64, f *gofeed.Feed
 				Guid:   item.GUID,
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			exists, err := queries.CheckArticleExistsByGUID(ctx, item.GUID)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if exists == 1 {
 				continue
 			}
-			_, err = queries.CreateArticle(ctx, db.CreateArticleParams{
-				FeedID: feedID,
-				Guid:   item.GUID,
-				Title:  item.Title,
-				Url:    item.Link,
-				IsRead: 0,
+			content := sanitize.HTML(itemContent(item), itemBaseURL(dbFeed.Url, f, item))
+			if dbFeed.FetchFullContent == 1 && item.Link != "" {
+				fullContent, err := ft.FetchFullContent(ctx, item.Link)
+				if err != nil {
+					// Keep the content from the feed
+					slog.WarnContext(ctx, "Failed to fetch full content", "article_url", logging.URL(item.Link), "error", err)
+				} else {
+					content = fullContent
+				}
+			}
+			article, err := queries.CreateArticle(ctx, db.CreateArticleParams{
+				FeedID:      feedID,
+				Guid:        item.GUID,
+				Title:       item.Title,
+				Url:         item.Link,
+				IsRead:      0,
+				PublishedAt: publishedAt(item).Format(time.RFC3339),
+				Content:     content,
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
+			added = append(added, article)
 		}
 	}
-	return nil
+	metrics.SyncNewArticles.Observe(float64(len(added)))
+	return added, nil
 }
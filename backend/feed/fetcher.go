@@ -0,0 +1,240 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	neturl "net/url"
+	"syscall"
+	"time"
+
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/metrics"
+)
+
+var ErrTooLarge = errors.New("response too large")
+
+// ErrNonPublicAddress is returned when the public client is asked to connect to a loopback, private or other
+// non-public address.
+var ErrNonPublicAddress = errors.New("connection to non-public address refused")
+
+// FetcherConfig configures the HTTP client that fetches feeds and pages.
+type FetcherConfig struct {
+	// ConnectTimeout limits establishing a connection, including the TLS handshake
+	ConnectTimeout time.Duration
+	// Timeout limits a whole request, including reading the response. Feeds may override it.
+	Timeout time.Duration
+	// MaxSize is the maximum size of a response body in bytes
+	MaxSize int64
+	// UserAgent identifies feedaka to the sites it fetches
+	UserAgent string
+	// Proxy is the URL of an HTTP, HTTPS or SOCKS5 proxy. If empty, the proxy is taken from the environment.
+	// The public client does not use it, since it has to check the address it connects to.
+	Proxy string
+}
+
+// Fetcher fetches feeds and pages with one shared HTTP client.
+type Fetcher struct {
+	client       *http.Client
+	publicClient *http.Client
+	timeout      time.Duration
+	maxSize      int64
+}
+
+// RequestOptions are the per-feed settings of a request.
+type RequestOptions struct {
+	// Credentials are sent with the request if not nil
+	Credentials *credential.Credentials
+	// Timeout overrides the fetcher's timeout if positive
+	Timeout time.Duration
+	// Public makes the request with the public client, e.g. for URLs that are not subscribed to yet
+	Public bool
+}
+
+func NewFetcher(cfg FetcherConfig) (*Fetcher, error) {
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != "" {
+		u, err := neturl.Parse(cfg.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("unsupported proxy scheme: %s", u.Scheme)
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   cfg.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: cfg.ConnectTimeout,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	publicDialer := &net.Dialer{
+		Timeout:   cfg.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+		Control:   denyNonPublic,
+	}
+	publicTransport := transport.Clone()
+	publicTransport.Proxy = nil
+	publicTransport.DialContext = publicDialer.DialContext
+
+	return &Fetcher{
+		client: &http.Client{
+			Transport:     &userAgentTransport{base: transport, userAgent: cfg.UserAgent},
+			CheckRedirect: checkRedirect,
+		},
+		publicClient: &http.Client{
+			Transport:     &userAgentTransport{base: publicTransport, userAgent: cfg.UserAgent},
+			CheckRedirect: checkRedirect,
+		},
+		timeout: cfg.Timeout,
+		maxSize: cfg.MaxSize,
+	}, nil
+}
+
+// PublicClient returns an HTTP client that only connects to public addresses. It fetches URLs taken from feed
+// content, such as icons and images, which must not reach the loopback interface, the local network or cloud
+// metadata endpoints. It has no overall timeout, so requests need a deadline.
+// The address is checked on every connection, so redirects and DNS names resolving to private addresses are refused.
+func (ft *Fetcher) PublicClient() *http.Client {
+	return ft.publicClient
+}
+
+// denyNonPublic is the Control hook of the public client's dialer. It runs after DNS resolution, with the address
+// actually connected to.
+func denyNonPublic(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, address)
+	}
+	addr := addrPort.Addr().Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, addr)
+	}
+	return nil
+}
+
+// credentialsKey is the context key of the credentials sent with a request.
+type credentialsKey struct{}
+
+// checkRedirect follows up to 10 redirects like the default policy, and does not send the credentials to another
+// host or port.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	if req.URL.Host != via[0].URL.Host {
+		c, _ := req.Context().Value(credentialsKey{}).(*credential.Credentials)
+		c.Remove(req)
+	}
+	return nil
+}
+
+// Carrier-grade NAT range (RFC 6598), which is not covered by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// get downloads url and returns its body, the final URL after redirects and the media type.
+func (ft *Fetcher) get(ctx context.Context, url string, opts RequestOptions) (body []byte, finalURL *neturl.URL, contentType string, err error) {
+	defer func(start time.Time) {
+		metrics.FetchDuration.Observe(time.Since(start).Seconds())
+		metrics.FetchRequests.WithLabelValues(fetchOutcome(err)).Inc()
+		metrics.FetchBytes.Add(float64(len(body)))
+	}(time.Now())
+
+	timeout := ft.timeout
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if opts.Credentials != nil {
+		ctx = context.WithValue(ctx, credentialsKey{}, opts.Credentials)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	opts.Credentials.Apply(req)
+
+	client := ft.client
+	if opts.Public {
+		client = ft.publicClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, "", &HTTPError{URL: url, Status: resp.Status}
+	}
+	if resp.ContentLength > ft.maxSize {
+		return nil, nil, "", fmt.Errorf("failed to fetch %s: %w (%d bytes)", url, ErrTooLarge, resp.ContentLength)
+	}
+	body, err = io.ReadAll(io.LimitReader(resp.Body, ft.maxSize+1))
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	if int64(len(body)) > ft.maxSize {
+		return nil, nil, "", fmt.Errorf("failed to fetch %s: %w", url, ErrTooLarge)
+	}
+	return body, resp.Request.URL, resp.Header.Get("Content-Type"), nil
+}
+
+// HTTPError is returned when a server responds with a non-2xx status.
+type HTTPError struct {
+	URL    string
+	Status string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("failed to fetch %s: %s", e.URL, e.Status)
+}
+
+func fetchOutcome(err error) string {
+	var httpErr *HTTPError
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return metrics.OutcomeSuccess
+	case errors.As(err, &httpErr):
+		return metrics.OutcomeHTTPError
+	case errors.Is(err, ErrTooLarge):
+		return metrics.OutcomeTooLarge
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return metrics.OutcomeTimeout
+	default:
+		return metrics.OutcomeError
+	}
+}
+
+// userAgentTransport sets the User-Agent header of requests that do not have one.
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.base.RoundTrip(req)
+}
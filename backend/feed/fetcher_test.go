@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"undef.ninja/x/feedaka/credential"
+)
+
+func TestCredentialHeadersOnRedirect(t *testing.T) {
+	received := make(chan http.Header, 1)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+	}))
+	defer target.Close()
+
+	var origin *httptest.Server
+	origin = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/other-host":
+			http.Redirect(w, r, target.URL+"/feed.xml", http.StatusFound)
+		case "/same-host":
+			http.Redirect(w, r, "/feed.xml", http.StatusFound)
+		default:
+			received <- r.Header.Clone()
+		}
+	}))
+	defer origin.Close()
+
+	ft, err := NewFetcher(FetcherConfig{ConnectTimeout: time.Second, Timeout: 5 * time.Second, MaxSize: 1 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := RequestOptions{Credentials: &credential.Credentials{
+		BearerToken: "token",
+		Headers:     map[string]string{"X-Api-Key": "secret"},
+	}}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/other-host", ""},
+		{"/same-host", "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if _, _, _, err := ft.get(context.Background(), origin.URL+tt.path, opts); err != nil {
+				t.Fatal(err)
+			}
+			header := <-received
+			if got := header.Get("X-Api-Key"); got != tt.want {
+				t.Errorf("X-Api-Key = %q, want %q", got, tt.want)
+			}
+			if tt.want == "" && header.Get("Authorization") != "" {
+				t.Error("Authorization was sent to another port")
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+	"github.com/mmcdole/gofeed"
+
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/db"
+)
+
+// Selectors are the CSS selectors that extract items from a page that has no feed.
+// Item selects the item containers. The others are relative to each item and optional.
+type Selectors struct {
+	Item    string
+	Title   string
+	Link    string
+	Date    string
+	Content string
+}
+
+var ErrNoItemSelector = errors.New("item selector is required")
+
+// Date formats tried in order when parsing scraped dates
+var dateLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"2006.01.02",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"02 Jan 2006",
+}
+
+// Validate reports whether the selectors are valid CSS selectors.
+func (s Selectors) Validate() error {
+	if strings.TrimSpace(s.Item) == "" {
+		return ErrNoItemSelector
+	}
+	for _, sel := range []string{s.Item, s.Title, s.Link, s.Date, s.Content} {
+		if sel == "" {
+			continue
+		}
+		if _, err := cascadia.ParseGroup(sel); err != nil {
+			return fmt.Errorf("invalid selector %q: %w", sel, err)
+		}
+	}
+	return nil
+}
+
+func selectorsFromDB(s db.FeedScraper) Selectors {
+	return Selectors{
+		Item:    s.ItemSelector,
+		Title:   s.TitleSelector,
+		Link:    s.LinkSelector,
+		Date:    s.DateSelector,
+		Content: s.ContentSelector,
+	}
+}
+
+// GetSelectors returns the selectors of a scraped feed, or nil if the feed is a regular feed.
+func GetSelectors(ctx context.Context, queries db.Store, feedID int64) (*Selectors, error) {
+	s, err := queries.GetFeedScraper(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query feed scraper: %w", err)
+	}
+	selectors := selectorsFromDB(s)
+	return &selectors, nil
+}
+
+// FetchByID fetches a stored feed with its credentials and timeout, scraping its page if it is a scraped feed.
+func (ft *Fetcher) FetchByID(ctx context.Context, queries db.Store, credentials *credential.Store, feedID int64) (*gofeed.Feed, error) {
+	dbFeed, err := queries.GetFeed(ctx, feedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+	selectors, err := GetSelectors(ctx, queries, feedID)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := credentials.Get(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := RequestOptions{
+		Credentials: creds,
+		Timeout:     time.Duration(dbFeed.FetchTimeoutSeconds) * time.Second,
+	}
+	if selectors == nil {
+		return ft.Fetch(ctx, dbFeed.Url, opts)
+	}
+	return ft.Scrape(ctx, dbFeed.Url, *selectors, opts)
+}
+
+// Scrape downloads the page at url and extracts its items with the selectors, so that the page can be
+// synced like a feed. Items without a link are identified by their title.
+func (ft *Fetcher) Scrape(ctx context.Context, url string, selectors Selectors, opts RequestOptions) (*gofeed.Feed, error) {
+	if err := selectors.Validate(); err != nil {
+		return nil, err
+	}
+
+	page, finalURL, err := ft.fetchPage(ctx, url, opts)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", url, err)
+	}
+
+	f := &gofeed.Feed{
+		Title:    collapseSpace(doc.Find("title").First().Text()),
+		Link:     finalURL.String(),
+		FeedType: "scraped",
+	}
+	if f.Title == "" {
+		f.Title = url
+	}
+
+	doc.Find(selectors.Item).Each(func(_ int, s *goquery.Selection) {
+		item := scrapeItem(s, selectors, finalURL)
+		if item != nil {
+			f.Items = append(f.Items, item)
+		}
+	})
+	return f, nil
+}
+
+func scrapeItem(s *goquery.Selection, selectors Selectors, base *neturl.URL) *gofeed.Item {
+	var link *goquery.Selection
+	switch {
+	case selectors.Link != "":
+		link = s.Find(selectors.Link).First()
+	case goquery.NodeName(s) == "a":
+		link = s
+	default:
+		link = s.Find("a[href]").First()
+	}
+	item := &gofeed.Item{}
+	if href, ok := link.Attr("href"); ok {
+		if u, err := base.Parse(strings.TrimSpace(href)); err == nil {
+			item.Link = u.String()
+		}
+	}
+
+	if selectors.Title != "" {
+		item.Title = collapseSpace(s.Find(selectors.Title).First().Text())
+	} else {
+		item.Title = collapseSpace(link.Text())
+	}
+	if item.Title == "" && item.Link == "" {
+		return nil
+	}
+
+	if selectors.Date != "" {
+		date := s.Find(selectors.Date).First()
+		// <time datetime="..."> is more reliable than the displayed text
+		value, ok := date.Attr("datetime")
+		if !ok {
+			value = collapseSpace(date.Text())
+		}
+		if t, ok := parseDate(value); ok {
+			item.Published = value
+			item.PublishedParsed = &t
+		}
+	}
+
+	if selectors.Content != "" {
+		content, err := s.Find(selectors.Content).First().Html()
+		if err == nil {
+			item.Content = content
+		}
+	}
+
+	if item.Link != "" {
+		item.GUID = item.Link
+	} else {
+		item.GUID = base.String() + "#" + item.Title
+	}
+	return item
+}
+
+func parseDate(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
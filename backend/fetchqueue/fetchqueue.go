@@ -0,0 +1,435 @@
+package fetchqueue
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/favicon"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/lease"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/metrics"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
+)
+
+type Status int
+
+const (
+	// The job waits for a worker
+	StatusQueued Status = iota
+	// The feeds of the job are being fetched
+	StatusRunning
+	// All feeds of the job were fetched
+	StatusDone
+	// Some feeds of the job could not be fetched
+	StatusFailed
+)
+
+const (
+	// Scheduled jobs wait this long between feeds so that sites are not fetched in bursts
+	scheduledFeedInterval = 5 * time.Second
+	// Each user may request at most rateLimit refreshes within rateWindow. Requests joining an existing job are free.
+	rateLimit  = 10
+	rateWindow = time.Minute
+)
+
+// Config configures the workers of a queue.
+type Config struct {
+	// Number of jobs processed concurrently. The feeds of a job are fetched one by one.
+	Workers int
+	// Finished jobs can be polled for this long
+	JobRetention time.Duration
+}
+
+var ErrRateLimited = errors.New("too many refresh requests, try again later")
+
+// Job fetches a list of feeds of one user. Jobs returned by Queue are snapshots and are not updated.
+type Job struct {
+	ID      int64
+	UserID  int64
+	FeedIDs []int64
+	Status  Status
+	// Number of feeds fetched successfully and unsuccessfully so far
+	Fetched int
+	Failed  int
+	// Last error, if any feed could not be fetched
+	Err        string
+	CreatedAt  time.Time
+	FinishedAt time.Time
+
+	interval time.Duration
+}
+
+// Queue fetches feeds in the background. Feeds that are already queued are not queued again, so a feed
+// requested by several refreshes is fetched once and the later requests get the job that fetches it.
+type Queue struct {
+	queries     db.Store
+	fetcher     *feed.Fetcher
+	credentials *credential.Store
+	subscriber  *websub.Subscriber
+	bus         *pubsub.Bus
+	cfg         Config
+
+	mu     sync.Mutex
+	nextID int64
+	jobs   map[int64]*Job
+	queued []*Job
+	// Jobs that will fetch each feed, until the feed is fetched
+	pendingFeeds map[int64]*Job
+	// Times of the recent refresh requests of each user
+	requests map[int64][]time.Time
+	wake     chan struct{}
+	// Whether the workers are running, when they started, and when EnqueueDue last succeeded
+	running     bool
+	startedAt   time.Time
+	lastCycleAt time.Time
+	// Whether RunScheduler is running, and whether this process holds the lease and since when
+	lease       *lease.Lease
+	scheduling  bool
+	active      bool
+	activeSince time.Time
+}
+
+func New(queries db.Store, fetcher *feed.Fetcher, credentials *credential.Store, subscriber *websub.Subscriber, bus *pubsub.Bus, cfg Config) *Queue {
+	return &Queue{
+		queries:      queries,
+		fetcher:      fetcher,
+		credentials:  credentials,
+		subscriber:   subscriber,
+		bus:          bus,
+		cfg:          cfg,
+		jobs:         make(map[int64]*Job),
+		pendingFeeds: make(map[int64]*Job),
+		requests:     make(map[int64][]time.Time),
+		wake:         make(chan struct{}, cfg.Workers),
+		lease:        lease.New(queries, leaseName, leaseTTL),
+	}
+}
+
+// Run processes jobs until ctx is done.
+func (q *Queue) Run(ctx context.Context) {
+	q.mu.Lock()
+	q.running = true
+	q.startedAt = time.Now()
+	q.mu.Unlock()
+	defer func() {
+		q.mu.Lock()
+		q.running = false
+		q.mu.Unlock()
+	}()
+
+	var wg sync.WaitGroup
+	for range q.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// RefreshFeed queues a fetch of the feed requested by its owner.
+func (q *Queue) RefreshFeed(userID, feedID int64) (Job, error) {
+	return q.request(userID, []int64{feedID})
+}
+
+// RefreshAll queues a fetch of all feeds the user subscribes to.
+func (q *Queue) RefreshAll(ctx context.Context, userID int64) (Job, error) {
+	feeds, err := q.queries.GetFeeds(ctx, userID)
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to query feeds: %w", err)
+	}
+	feedIDs := make([]int64, 0, len(feeds))
+	for _, f := range feeds {
+		feedIDs = append(feedIDs, f.ID)
+	}
+	return q.request(userID, feedIDs)
+}
+
+// RefreshFolder queues a fetch of the subscribed feeds in the folder. The caller checks that the user owns it.
+func (q *Queue) RefreshFolder(ctx context.Context, userID, folderID int64) (Job, error) {
+	feeds, err := q.queries.GetFeedsByFolder(ctx, sql.NullInt64{Int64: folderID, Valid: true})
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to query feeds: %w", err)
+	}
+	feedIDs := make([]int64, 0, len(feeds))
+	for _, f := range feeds {
+		feedIDs = append(feedIDs, f.ID)
+	}
+	return q.request(userID, feedIDs)
+}
+
+func (q *Queue) request(userID int64, feedIDs []int64) (Job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.prune()
+
+	unqueued := q.unqueued(feedIDs)
+	if len(feedIDs) > 0 && len(unqueued) == 0 {
+		// Everything is already queued. Return the newest job that fetches one of the feeds.
+		return q.pendingJob(feedIDs), nil
+	}
+
+	now := time.Now()
+	recent := slices.DeleteFunc(q.requests[userID], func(t time.Time) bool {
+		return now.Sub(t) >= rateWindow
+	})
+	if len(recent) >= rateLimit {
+		q.requests[userID] = recent
+		return Job{}, ErrRateLimited
+	}
+	q.requests[userID] = append(recent, now)
+
+	return q.enqueue(userID, unqueued, 0), nil
+}
+
+// EnqueueDue queues the feeds that are due to be fetched, one job per user. It is called periodically
+// and is not rate-limited.
+func (q *Queue) EnqueueDue(ctx context.Context) error {
+	feeds, err := q.queries.GetFeedsToFetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query feeds: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var userIDs []int64
+	byUser := make(map[int64][]int64)
+	for _, f := range feeds {
+		fetchedAt, err := time.Parse(time.RFC3339, f.FetchedAt)
+		if err != nil {
+			return fmt.Errorf("invalid fetched_at of feed %d: %w", f.ID, err)
+		}
+		if !isDue(fetchedAt, f.WebsubState.String, f.WebsubLeaseExpiresAt.String, now) {
+			continue
+		}
+		if _, ok := byUser[f.UserID]; !ok {
+			userIDs = append(userIDs, f.UserID)
+		}
+		byUser[f.UserID] = append(byUser[f.UserID], f.ID)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.lastCycleAt = time.Now()
+	q.prune()
+	for _, userID := range userIDs {
+		feedIDs := q.unqueued(byUser[userID])
+		if len(feedIDs) > 0 {
+			q.enqueue(userID, feedIDs, scheduledFeedInterval)
+		}
+	}
+	return nil
+}
+
+// Feeds with an active WebSub subscription are pushed by the hub, so they are polled only occasionally.
+const pushedFeedFetchInterval = 24 * time.Hour
+
+func isDue(fetchedAt time.Time, websubState, websubLeaseExpiresAt string, now time.Time) bool {
+	if now.Sub(fetchedAt) <= 10*time.Minute {
+		return false
+	}
+	if websub.IsPushActive(websubState, websubLeaseExpiresAt, now) && now.Sub(fetchedAt) < pushedFeedFetchInterval {
+		return false
+	}
+	return true
+}
+
+// Get returns the job if it belongs to the user and has not been pruned.
+func (q *Queue) Get(userID, jobID int64) (Job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[jobID]
+	if !ok || job.UserID != userID {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+// unqueued returns the feeds that no job will fetch. The caller must hold q.mu.
+func (q *Queue) unqueued(feedIDs []int64) []int64 {
+	var result []int64
+	for _, feedID := range feedIDs {
+		if _, ok := q.pendingFeeds[feedID]; !ok {
+			result = append(result, feedID)
+		}
+	}
+	return result
+}
+
+// pendingJob returns the newest job that will fetch one of the feeds. The caller must hold q.mu.
+func (q *Queue) pendingJob(feedIDs []int64) Job {
+	var latest *Job
+	for _, feedID := range feedIDs {
+		job, ok := q.pendingFeeds[feedID]
+		if ok && (latest == nil || job.ID > latest.ID) {
+			latest = job
+		}
+	}
+	return *latest
+}
+
+// enqueue adds a job and wakes a worker. The caller must hold q.mu.
+func (q *Queue) enqueue(userID int64, feedIDs []int64, interval time.Duration) Job {
+	q.nextID++
+	job := &Job{
+		ID:        q.nextID,
+		UserID:    userID,
+		FeedIDs:   feedIDs,
+		Status:    StatusQueued,
+		CreatedAt: time.Now().UTC(),
+		interval:  interval,
+	}
+	q.jobs[job.ID] = job
+	if len(feedIDs) == 0 {
+		// Nothing to fetch, e.g. the user has no feeds
+		job.Status = StatusDone
+		job.FinishedAt = job.CreatedAt
+		q.publish(job)
+		return *job
+	}
+	q.queued = append(q.queued, job)
+	for _, feedID := range feedIDs {
+		q.pendingFeeds[feedID] = job
+	}
+	metrics.FetchQueueDepth.Set(float64(len(q.pendingFeeds)))
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	q.publish(job)
+	return *job
+}
+
+// prune forgets finished jobs after the configured retention. The caller must hold q.mu.
+func (q *Queue) prune() {
+	for id, job := range q.jobs {
+		if !job.FinishedAt.IsZero() && time.Since(job.FinishedAt) > q.cfg.JobRetention {
+			delete(q.jobs, id)
+		}
+	}
+}
+
+func (q *Queue) work(ctx context.Context) {
+	for {
+		job := q.next()
+		if job == nil {
+			select {
+			case <-q.wake:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		q.process(ctx, job)
+	}
+}
+
+// next takes the oldest queued job and marks it running, or returns nil if there is none.
+func (q *Queue) next() *Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.queued) == 0 {
+		return nil
+	}
+	job := q.queued[0]
+	q.queued = q.queued[1:]
+	job.Status = StatusRunning
+	metrics.FetchQueueWait.Observe(time.Since(job.CreatedAt).Seconds())
+	q.publish(job)
+	return job
+}
+
+func (q *Queue) process(ctx context.Context, job *Job) {
+	ctx = logging.With(ctx, "job_id", job.ID, "user_id", job.UserID)
+	slog.InfoContext(ctx, "Refresh job started", "feeds", len(job.FeedIDs))
+
+	for i, feedID := range job.FeedIDs {
+		if i > 0 && job.interval > 0 {
+			select {
+			case <-time.After(job.interval):
+			case <-ctx.Done():
+			}
+		}
+
+		feedCtx := logging.With(ctx, "feed_id", feedID)
+		var err error
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		} else {
+			_, err = q.Fetch(feedCtx, feedID)
+		}
+		if err != nil {
+			slog.WarnContext(feedCtx, "Failed to fetch feed", "error", err)
+		}
+
+		q.mu.Lock()
+		if q.pendingFeeds[feedID] == job {
+			delete(q.pendingFeeds, feedID)
+			metrics.FetchQueueDepth.Set(float64(len(q.pendingFeeds)))
+		}
+		if err != nil {
+			job.Failed++
+			job.Err = err.Error()
+		} else {
+			job.Fetched++
+		}
+		if i == len(job.FeedIDs)-1 {
+			job.FinishedAt = time.Now().UTC()
+			if job.Failed > 0 {
+				job.Status = StatusFailed
+			} else {
+				job.Status = StatusDone
+			}
+		}
+		q.publish(job)
+		q.mu.Unlock()
+	}
+	slog.InfoContext(ctx, "Refresh job finished", "fetched", job.Fetched, "failed", job.Failed)
+}
+
+// Fetch fetches and syncs the feed right away, bypassing the queue, and returns the number of new articles.
+func (q *Queue) Fetch(ctx context.Context, feedID int64) (int, error) {
+	dbFeed, err := q.queries.GetFeed(ctx, feedID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	ctx = logging.With(ctx, "feed_url", logging.URL(dbFeed.Url))
+	slog.InfoContext(ctx, "Fetching feed")
+	f, err := q.fetcher.FetchByID(ctx, q.queries, q.credentials, feedID)
+	if err != nil {
+		return 0, err
+	}
+	added, err := q.fetcher.Sync(ctx, q.queries, feedID, f)
+	if err != nil {
+		return 0, err
+	}
+	q.bus.PublishFeedSynced(dbFeed.UserID, feedID, added)
+
+	err = favicon.Refresh(ctx, q.queries, q.fetcher.PublicClient(), feedID, dbFeed.Url, f)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to refresh icon", "error", err)
+	}
+	err = q.subscriber.Subscribe(ctx, feedID, dbFeed.Url, f)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to subscribe to WebSub hub", "error", err)
+	}
+	return len(added), nil
+}
+
+// publish notifies the job's owner that its status changed. The caller must hold q.mu.
+func (q *Queue) publish(job *Job) {
+	q.bus.Publish(job.UserID, pubsub.Event{Type: pubsub.RefreshJobUpdated, JobID: job.ID})
+}
@@ -0,0 +1,216 @@
+package fetchqueue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// Name of the lease that the process fetching feeds on schedule holds
+	leaseName = "fetcher"
+	// Another process takes over within this long after the holder of the lease stops without releasing it
+	leaseTTL = time.Minute
+	// The holder renews the lease this often, so that one failed renewal does not lose it
+	leaseRenewInterval  = leaseTTL / 3
+	webSubRenewInterval = time.Hour
+	// Timeout of releasing the lease on shutdown
+	releaseTimeout = 5 * time.Second
+)
+
+var errLeaseLost = errors.New("lost the fetcher lease to another process")
+
+// SchedulerStatus describes the workers and the scheduler of a queue.
+type SchedulerStatus struct {
+	// Whether Run is running and when it started
+	Running   bool
+	StartedAt time.Time
+	// Whether RunScheduler is running. If not, feeds are fetched only on request.
+	Enabled bool
+	// Whether this process holds the fetcher lease and since when. If not, another process fetches feeds on
+	// schedule and this one stands by.
+	Active      bool
+	ActiveSince time.Time
+	// When EnqueueDue last succeeded. It is zero if it has not succeeded yet.
+	LastCycleAt time.Time
+}
+
+// SchedulerStatus reports the state of the workers and the scheduler.
+func (q *Queue) SchedulerStatus() SchedulerStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return SchedulerStatus{
+		Running:     q.running,
+		StartedAt:   q.startedAt,
+		Enabled:     q.scheduling,
+		Active:      q.active,
+		ActiveSince: q.activeSince,
+		LastCycleAt: q.lastCycleAt,
+	}
+}
+
+// RunScheduler queues the due feeds every interval and renews WebSub subscriptions until ctx is done. The processes
+// sharing the database take turns through a lease: only its holder fetches feeds on schedule, and the others stand
+// by to take over when it stops. Refreshes requested from a process are fetched by its own workers either way.
+func (q *Queue) RunScheduler(ctx context.Context, interval time.Duration) {
+	q.mu.Lock()
+	q.scheduling = true
+	q.mu.Unlock()
+	defer func() {
+		q.mu.Lock()
+		q.scheduling = false
+		q.mu.Unlock()
+	}()
+
+	if !q.renewLease(ctx) {
+		slog.InfoContext(ctx, "Another process fetches feeds on schedule; standing by")
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		q.keepLease(ctx, nil)
+	}()
+	defer wg.Wait()
+
+	cycle := time.NewTicker(interval)
+	defer cycle.Stop()
+	renewWebSub := time.NewTicker(webSubRenewInterval)
+	defer renewWebSub.Stop()
+	for {
+		select {
+		case <-cycle.C:
+			if q.isActive() {
+				if err := q.EnqueueDue(ctx); err != nil {
+					slog.ErrorContext(ctx, "Failed to queue feeds", "error", err)
+				}
+			}
+		case <-renewWebSub.C:
+			if q.isActive() {
+				if err := q.subscriber.RenewExpiring(ctx); err != nil {
+					slog.ErrorContext(ctx, "Failed to renew WebSub subscriptions", "error", err)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce queues the due feeds, renews WebSub subscriptions and fetches the feeds, holding the fetcher lease until
+// it finishes. It returns false without fetching anything if another process holds the lease.
+func (q *Queue) RunOnce(ctx context.Context) (bool, error) {
+	if !q.renewLease(ctx) {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Stop fetching if another process took over, e.g. because the database was unreachable for too long
+		q.keepLease(ctx, func() { cancel(errLeaseLost) })
+	}()
+	defer wg.Wait()
+	defer cancel(nil)
+
+	if err := q.EnqueueDue(ctx); err != nil {
+		return true, err
+	}
+	if err := q.subscriber.RenewExpiring(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to renew WebSub subscriptions", "error", err)
+	}
+	fetched, failed := q.drain(ctx)
+	slog.InfoContext(ctx, "Fetched due feeds", "fetched", fetched, "failed", failed)
+	if err := context.Cause(ctx); errors.Is(err, errLeaseLost) {
+		return true, err
+	}
+	return true, nil
+}
+
+func (q *Queue) isActive() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.active
+}
+
+// renewLease acquires or extends the fetcher lease and reports whether this process holds it.
+func (q *Queue) renewLease(ctx context.Context) bool {
+	held, err := q.lease.Acquire(ctx)
+	if err != nil {
+		// Another process may take over once the lease expires, so stand by until it is renewed
+		slog.ErrorContext(ctx, "Failed to renew fetcher lease", "error", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if held && !q.active {
+		q.activeSince = time.Now()
+		slog.InfoContext(ctx, "Acquired fetcher lease", "holder", q.lease.Holder())
+	} else if !held && q.active {
+		slog.WarnContext(ctx, "Lost fetcher lease", "holder", q.lease.Holder())
+	}
+	q.active = held
+	return held
+}
+
+// keepLease renews the fetcher lease every leaseRenewInterval until ctx is done, and then releases it. lost is
+// called, if not nil, whenever a renewal finds that this process does not hold the lease.
+func (q *Queue) keepLease(ctx context.Context, lost func()) {
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !q.renewLease(ctx) && lost != nil {
+				lost()
+			}
+		case <-ctx.Done():
+			q.releaseLease()
+			return
+		}
+	}
+}
+
+// releaseLease lets another process take over right away instead of after the lease expires.
+func (q *Queue) releaseLease() {
+	q.mu.Lock()
+	active := q.active
+	q.active = false
+	q.mu.Unlock()
+	if !active {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if err := q.lease.Release(ctx); err != nil {
+		slog.Error("Failed to release fetcher lease", "error", err)
+		return
+	}
+	slog.Info("Released fetcher lease", "holder", q.lease.Holder())
+}
+
+// drain processes the queued jobs with the configured number of workers until none are left, and returns the
+// number of feeds fetched successfully and unsuccessfully.
+func (q *Queue) drain(ctx context.Context) (fetched, failed int) {
+	var wg sync.WaitGroup
+	for range q.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := q.next(); job != nil; job = q.next() {
+				q.process(ctx, job)
+				q.mu.Lock()
+				fetched += job.Fetched
+				failed += job.Failed
+				q.mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return fetched, failed
+}
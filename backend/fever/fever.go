@@ -0,0 +1,436 @@
+package fever
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/sanitize"
+)
+
+// Fever API: https://web.archive.org/web/20230616124016/https://feedafever.com/api
+
+const apiVersion = 3
+
+// Maximum number of items returned by a single "items" request
+const itemsLimit = 50
+
+// Maximum number of IDs accepted by "with_ids"
+const maxWithIDs = 50
+
+type Handler struct {
+	queries db.Store
+	bus     *pubsub.Bus
+}
+
+func NewHandler(queries db.Store, bus *pubsub.Bus) *Handler {
+	return &Handler{
+		queries: queries,
+		bus:     bus,
+	}
+}
+
+type group struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+type feedsGroup struct {
+	GroupID int64  `json:"group_id"`
+	FeedIDs string `json:"feed_ids"`
+}
+
+type feed struct {
+	ID                int64  `json:"id"`
+	FaviconID         int64  `json:"favicon_id"`
+	Title             string `json:"title"`
+	URL               string `json:"url"`
+	SiteURL           string `json:"site_url"`
+	IsSpark           int    `json:"is_spark"`
+	LastUpdatedOnTime int64  `json:"last_updated_on_time"`
+}
+
+type favicon struct {
+	ID   int64  `json:"id"`
+	Data string `json:"data"`
+}
+
+type item struct {
+	ID            int64  `json:"id"`
+	FeedID        int64  `json:"feed_id"`
+	Title         string `json:"title"`
+	Author        string `json:"author"`
+	HTML          string `json:"html"`
+	URL           string `json:"url"`
+	IsSaved       int64  `json:"is_saved"`
+	IsRead        int64  `json:"is_read"`
+	CreatedOnTime int64  `json:"created_on_time"`
+}
+
+// Handle serves all Fever API requests. Requests are authenticated by the api_key parameter.
+func (h *Handler) Handle(c echo.Context) error {
+	ctx := c.Request().Context()
+	resp := map[string]any{
+		"api_version": apiVersion,
+		"auth":        0,
+	}
+
+	apiKey := strings.ToLower(c.FormValue("api_key"))
+	if apiKey == "" {
+		return c.JSON(http.StatusOK, resp)
+	}
+	user, err := h.queries.GetUserByFeverAPIKey(ctx, sql.NullString{String: apiKey, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusOK, resp)
+		}
+		return err
+	}
+	resp["auth"] = 1
+
+	feeds, err := h.queries.GetFeeds(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	resp["last_refreshed_on_time"] = lastRefreshedOnTime(feeds)
+
+	if c.FormValue("mark") != "" {
+		if err := h.mark(ctx, c, user.ID); err != nil {
+			return err
+		}
+	}
+
+	if has(c, "groups") || has(c, "feeds") {
+		// Folders are groups. Feeds that are in no folder are ungrouped.
+		folders, err := h.queries.GetFolders(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if has(c, "groups") {
+			groups := make([]group, 0, len(folders))
+			for _, f := range folders {
+				groups = append(groups, group{ID: f.ID, Title: f.Name})
+			}
+			resp["groups"] = groups
+		}
+		resp["feeds_groups"] = feedsGroups(folders, feeds)
+	}
+	var icons []db.FeedIcon
+	if has(c, "feeds") || has(c, "favicons") {
+		icons, err = h.queries.GetFeedIconsByUser(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+	}
+	if has(c, "feeds") {
+		// The favicon of a feed has the same ID as the feed
+		hasIcon := make(map[int64]bool, len(icons))
+		for _, icon := range icons {
+			hasIcon[icon.FeedID] = true
+		}
+		result := make([]feed, 0, len(feeds))
+		for _, f := range feeds {
+			var faviconID int64
+			if hasIcon[f.ID] {
+				faviconID = f.ID
+			}
+			result = append(result, feed{
+				ID:                f.ID,
+				FaviconID:         faviconID,
+				Title:             f.Title,
+				URL:               f.Url,
+				LastUpdatedOnTime: unixTime(f.FetchedAt),
+			})
+		}
+		resp["feeds"] = result
+	}
+	if has(c, "favicons") {
+		result := make([]favicon, 0, len(icons))
+		for _, icon := range icons {
+			result = append(result, favicon{
+				ID:   icon.FeedID,
+				Data: "image/png;base64," + base64.StdEncoding.EncodeToString(icon.Data),
+			})
+		}
+		resp["favicons"] = result
+	}
+	if has(c, "items") {
+		items, err := h.items(ctx, c, user.ID)
+		if err != nil {
+			return err
+		}
+		total, err := h.queries.CountArticles(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		resp["items"] = items
+		resp["total_items"] = total
+	}
+	if has(c, "links") {
+		// Hot links are not supported
+		resp["links"] = []any{}
+	}
+	if has(c, "unread_item_ids") {
+		ids, err := h.queries.GetUnreadArticleIDs(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		resp["unread_item_ids"] = joinIDs(ids)
+	}
+	if has(c, "saved_item_ids") {
+		ids, err := h.queries.GetStarredArticleIDs(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		resp["saved_item_ids"] = joinIDs(ids)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) items(ctx context.Context, c echo.Context, userID int64) ([]item, error) {
+	var articles []db.Article
+	var err error
+	if withIDs := c.QueryParam("with_ids"); withIDs != "" {
+		ids := splitIDs(withIDs)
+		if len(ids) > maxWithIDs {
+			ids = ids[:maxWithIDs]
+		}
+		articles, err = h.queries.GetArticlesByIDs(ctx, db.GetArticlesByIDsParams{
+			UserID: userID,
+			Ids:    ids,
+		})
+	} else if maxID, _ := strconv.ParseInt(c.QueryParam("max_id"), 10, 64); maxID > 0 {
+		articles, err = h.queries.GetArticlesBeforeID(ctx, db.GetArticlesBeforeIDParams{
+			UserID:   userID,
+			BeforeID: maxID,
+			Limit:    itemsLimit,
+		})
+	} else {
+		sinceID, _ := strconv.ParseInt(c.QueryParam("since_id"), 10, 64)
+		articles, err = h.queries.GetArticlesAfterID(ctx, db.GetArticlesAfterIDParams{
+			UserID:  userID,
+			AfterID: sinceID,
+			Limit:   itemsLimit,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]item, 0, len(articles))
+	for _, a := range articles {
+		items = append(items, item{
+			ID:            a.ID,
+			FeedID:        a.FeedID,
+			Title:         a.Title,
+			HTML:          sanitize.HTML(a.Content, a.Url),
+			URL:           a.Url,
+			IsSaved:       a.IsStarred,
+			IsRead:        a.IsRead,
+			CreatedOnTime: unixTime(a.PublishedAt),
+		})
+	}
+	return items, nil
+}
+
+func (h *Handler) mark(ctx context.Context, c echo.Context, userID int64) error {
+	id, err := strconv.ParseInt(c.FormValue("id"), 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest
+	}
+	as := c.FormValue("as")
+	before := time.Now()
+	if b, err := strconv.ParseInt(c.FormValue("before"), 10, 64); err == nil {
+		before = time.Unix(b, 0)
+	}
+	beforeStr := before.UTC().Format(time.RFC3339)
+
+	switch c.FormValue("mark") {
+	case "item":
+		article, err := h.queries.GetArticle(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+		f, err := h.queries.GetFeed(ctx, article.FeedID)
+		if err != nil {
+			return err
+		}
+		if f.UserID != userID {
+			return nil
+		}
+
+		switch as {
+		case "read", "unread":
+			isRead := int64(0)
+			if as == "read" {
+				isRead = 1
+			}
+			err = h.queries.UpdateArticleReadStatus(ctx, db.UpdateArticleReadStatusParams{
+				IsRead: isRead,
+				ID:     article.ID,
+			})
+			if err != nil {
+				return err
+			}
+			h.bus.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+			h.bus.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+		case "saved", "unsaved":
+			isStarred := int64(0)
+			if as == "saved" {
+				isStarred = 1
+			}
+			err = h.queries.UpdateArticleStarredStatus(ctx, db.UpdateArticleStarredStatusParams{
+				IsStarred: isStarred,
+				ID:        article.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	case "feed":
+		if as != "read" {
+			return nil
+		}
+		f, err := h.queries.GetFeed(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+		if f.UserID != userID {
+			return nil
+		}
+		err = h.queries.MarkFeedArticlesReadBefore(ctx, db.MarkFeedArticlesReadBeforeParams{
+			FeedID:      f.ID,
+			PublishedAt: beforeStr,
+		})
+		if err != nil {
+			return err
+		}
+		h.bus.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+		h.bus.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+	case "group":
+		if as != "read" {
+			return nil
+		}
+		// Group 0 is the "Kindling" super group containing all feeds
+		if id == 0 {
+			err = h.queries.MarkAllArticlesReadBefore(ctx, db.MarkAllArticlesReadBeforeParams{
+				Before: beforeStr,
+				UserID: userID,
+			})
+			if err != nil {
+				return err
+			}
+			h.bus.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+			return nil
+		}
+
+		folder, err := h.queries.GetFolder(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+		if folder.UserID != userID {
+			return nil
+		}
+		var feeds []db.Feed
+		err = h.queries.InTx(ctx, func(qtx db.Store) error {
+			feeds, err = qtx.GetFeedsByFolder(ctx, sql.NullInt64{Int64: folder.ID, Valid: true})
+			if err != nil {
+				return err
+			}
+			for _, f := range feeds {
+				err := qtx.MarkFeedArticlesReadBefore(ctx, db.MarkFeedArticlesReadBeforeParams{
+					FeedID:      f.ID,
+					PublishedAt: beforeStr,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, f := range feeds {
+			h.bus.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+		}
+		h.bus.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+	}
+	return nil
+}
+
+// feedsGroups returns the feeds of each folder that has any.
+func feedsGroups(folders []db.Folder, feeds []db.Feed) []feedsGroup {
+	feedIDs := make(map[int64][]int64, len(folders))
+	for _, f := range feeds {
+		if f.FolderID.Valid {
+			feedIDs[f.FolderID.Int64] = append(feedIDs[f.FolderID.Int64], f.ID)
+		}
+	}
+	result := make([]feedsGroup, 0, len(feedIDs))
+	for _, f := range folders {
+		if ids, ok := feedIDs[f.ID]; ok {
+			result = append(result, feedsGroup{GroupID: f.ID, FeedIDs: joinIDs(ids)})
+		}
+	}
+	return result
+}
+
+// has reports whether the request has the given argument, e.g. "?api&items".
+func has(c echo.Context, name string) bool {
+	_, ok := c.QueryParams()[name]
+	return ok
+}
+
+func unixTime(s string) int64 {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+func lastRefreshedOnTime(feeds []db.Feed) int64 {
+	var last int64
+	for _, f := range feeds {
+		last = max(last, unixTime(f.FetchedAt))
+	}
+	return last
+}
+
+func joinIDs(ids []int64) string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(strs, ",")
+}
+
+func splitIDs(s string) []int64 {
+	var ids []int64
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
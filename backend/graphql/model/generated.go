@@ -2,6 +2,29 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Personal API token for scripts and third-party clients. It is sent as "Authorization: Bearer <token>".
+type APIToken struct {
+	// Unique identifier for the API token
+	ID string `json:"id"`
+	// Name to tell tokens apart
+	Name string `json:"name"`
+	// What the token is allowed to do
+	Scope APITokenScope `json:"scope"`
+	// Timestamp when the token expires. Null means it never expires.
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	// Timestamp when the token was last used
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
+	// Timestamp when the token was created
+	CreatedAt string `json:"createdAt"`
+}
+
 // Represents an individual article/post from a feed
 type Article struct {
 	// Unique identifier for the article
@@ -16,6 +39,8 @@ type Article struct {
 	URL string `json:"url"`
 	// Whether the article has been marked as read
 	IsRead bool `json:"isRead"`
+	// HTML content of the article, from the feed or extracted from the article page
+	Content string `json:"content"`
 	// The feed this article belongs to
 	Feed *Feed `json:"feed"`
 }
@@ -26,6 +51,67 @@ type AuthPayload struct {
 	User *User `json:"user"`
 }
 
+// Input for creating an API token
+type CreateAPITokenInput struct {
+	// Name to tell tokens apart
+	Name string `json:"name"`
+	// What the token is allowed to do
+	Scope APITokenScope `json:"scope"`
+	// Timestamp when the token expires. Null means it never expires.
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+// Payload returned from createApiToken mutation
+type CreateAPITokenPayload struct {
+	// The created API token
+	APIToken *APIToken `json:"apiToken"`
+	// The secret token. It is not stored and cannot be retrieved again.
+	Token string `json:"token"`
+}
+
+// Input for creating an output feed
+type CreateOutputFeedInput struct {
+	// Title of the generated feed
+	Title string `json:"title"`
+	// Which articles the output feed contains
+	Source OutputFeedSource `json:"source"`
+	// ID of the feed whose articles are included. Required if source is FEED.
+	FeedID *string `json:"feedId,omitempty"`
+	// ID of the folder whose feeds' articles are included. Required if source is FOLDER.
+	FolderID *string `json:"folderId,omitempty"`
+	// Search query matched against article titles. Required if source is SEARCH.
+	// "%" and "_" act as wildcards.
+	Query *string `json:"query,omitempty"`
+}
+
+// Settings for the email digest of new unread articles
+type DigestSettings struct {
+	// Email address the digest is sent to
+	Email string `json:"email"`
+	// How often the digest is sent
+	Frequency DigestFrequency `json:"frequency"`
+	// Minimum number of new articles required to send a digest
+	MinArticles int32 `json:"minArticles"`
+	// IDs of the feeds included in the digest. If both feedIds and folderIds are empty, all feeds are included.
+	FeedIds []string `json:"feedIds"`
+	// IDs of the folders whose feeds are included in the digest
+	FolderIds []string `json:"folderIds"`
+}
+
+// Input for updating digest settings
+type DigestSettingsInput struct {
+	// Email address the digest is sent to
+	Email string `json:"email"`
+	// How often the digest is sent
+	Frequency DigestFrequency `json:"frequency"`
+	// Minimum number of new articles required to send a digest
+	MinArticles int32 `json:"minArticles"`
+	// IDs of the feeds included in the digest. If both feedIds and folderIds are empty, all feeds are included.
+	FeedIds []string `json:"feedIds"`
+	// IDs of the folders whose feeds are included in the digest
+	FolderIds []string `json:"folderIds"`
+}
+
 // Represents a feed subscription in the system
 type Feed struct {
 	// Unique identifier for the feed
@@ -38,18 +124,158 @@ type Feed struct {
 	FetchedAt string `json:"fetchedAt"`
 	// Whether the user is currently subscribed to this feed
 	IsSubscribed bool `json:"isSubscribed"`
+	// Whether the full content of new articles is extracted from their pages
+	FetchFullContent bool `json:"fetchFullContent"`
+	// URL of the site's icon, or null if none was found
+	IconURL *string `json:"iconUrl,omitempty"`
+	// Selectors used to scrape the page at url, or null if this is a regular RSS/Atom feed
+	ScrapeSelectors *ScrapeSelectors `json:"scrapeSelectors,omitempty"`
+	// Whether credentials are sent when fetching the feed. The credentials themselves are never returned.
+	HasCredentials bool `json:"hasCredentials"`
+	// Timeout in seconds of requests fetching the feed, or null if the server's default is used
+	FetchTimeoutSeconds *int32 `json:"fetchTimeoutSeconds,omitempty"`
+	// ID of the folder containing the feed, or null if it is in no folder
+	FolderID *string `json:"folderId,omitempty"`
 	// Articles belonging to this feed
 	Articles []*Article `json:"articles"`
 }
 
+// Credentials sent with every request for a private feed. They are stored encrypted and never returned by the API.
+type FeedCredentialsInput struct {
+	// Username for HTTP basic authentication
+	Username *string `json:"username,omitempty"`
+	// Password for HTTP basic authentication
+	Password *string `json:"password,omitempty"`
+	// Token sent in the "Authorization: Bearer" header
+	BearerToken *string `json:"bearerToken,omitempty"`
+	// Value of the Cookie header
+	Cookie *string `json:"cookie,omitempty"`
+	// Additional request headers
+	Headers []*HTTPHeaderInput `json:"headers,omitempty"`
+}
+
+// Folder grouping feeds. A feed is in at most one folder.
+type Folder struct {
+	// Unique identifier for the folder
+	ID string `json:"id"`
+	// Name of the folder, unique per user
+	Name string `json:"name"`
+	// Subscribed feeds in the folder
+	Feeds []*Feed `json:"feeds"`
+}
+
+// An HTTP request header
+type HTTPHeaderInput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // Root mutation type for modifying data
 type Mutation struct {
 }
 
+// Feed generated from the user's articles. It is served without authentication at
+// /output/<token>.rss, /output/<token>.atom and /output/<token>.json.
+type OutputFeed struct {
+	// Unique identifier for the output feed
+	ID string `json:"id"`
+	// Title of the generated feed
+	Title string `json:"title"`
+	// Which articles the output feed contains
+	Source OutputFeedSource `json:"source"`
+	// ID of the feed whose articles are included, if source is FEED
+	FeedID *string `json:"feedId,omitempty"`
+	// ID of the folder whose feeds' articles are included, if source is FOLDER
+	FolderID *string `json:"folderId,omitempty"`
+	// Search query matched against article titles, if source is SEARCH
+	Query *string `json:"query,omitempty"`
+	// Unguessable token in the URL of the output feed
+	Token string `json:"token"`
+	// Timestamp when the output feed was created
+	CreatedAt string `json:"createdAt"`
+}
+
 // Root query type for reading data
 type Query struct {
 }
 
+// Background job fetching feeds, returned by the refresh mutations. Finished jobs are kept for an hour.
+type RefreshJob struct {
+	// Unique identifier for the job
+	ID string `json:"id"`
+	// Status of the job
+	Status RefreshJobStatus `json:"status"`
+	// IDs of the feeds the job fetches. Feeds that were already queued by another job are not included.
+	FeedIds []string `json:"feedIds"`
+	// Number of feeds fetched successfully so far
+	FetchedCount int32 `json:"fetchedCount"`
+	// Number of feeds that could not be fetched so far
+	FailedCount int32 `json:"failedCount"`
+	// Last error, if a feed could not be fetched
+	Error *string `json:"error,omitempty"`
+	// Timestamp when the job was queued
+	CreatedAt string `json:"createdAt"`
+	// Timestamp when the job finished
+	FinishedAt *string `json:"finishedAt,omitempty"`
+}
+
+// CSS selectors that extract articles from a page that has no RSS/Atom feed
+type ScrapeSelectors struct {
+	// Selects the element of each article
+	Item string `json:"item"`
+	// Selects the title within an article. Defaults to the text of the link.
+	Title *string `json:"title,omitempty"`
+	// Selects the link within an article. Defaults to the article element if it is a link, or its first link.
+	Link *string `json:"link,omitempty"`
+	// Selects the publication date within an article, read from its datetime attribute or its text
+	Date *string `json:"date,omitempty"`
+	// Selects the HTML content within an article
+	Content *string `json:"content,omitempty"`
+}
+
+// Input for the CSS selectors of a scraped feed. See ScrapeSelectors.
+type ScrapeSelectorsInput struct {
+	Item    string  `json:"item"`
+	Title   *string `json:"title,omitempty"`
+	Link    *string `json:"link,omitempty"`
+	Date    *string `json:"date,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+// Result of scraping a page without saving it as a feed
+type ScrapedFeedPreview struct {
+	// Title of the page, used as the feed title
+	Title string `json:"title"`
+	// Articles extracted from the page
+	Items []*ScrapedItem `json:"items"`
+}
+
+// An article extracted from a page by previewScrapedFeed
+type ScrapedItem struct {
+	// Title of the article
+	Title string `json:"title"`
+	// URL of the article
+	URL string `json:"url"`
+	// Publication date, or null if none was selected or it could not be parsed
+	PublishedAt *string `json:"publishedAt,omitempty"`
+	// Sanitized HTML content of the article
+	Content string `json:"content"`
+}
+
+// Root subscription type for receiving live updates
+type Subscription struct {
+}
+
+// Input for updating feed settings. Null fields are left unchanged.
+type UpdateFeedInput struct {
+	// Whether the full content of new articles is extracted from their pages
+	FetchFullContent *bool `json:"fetchFullContent,omitempty"`
+	// Replaces the credentials of the feed. Pass an input with no fields set to remove them.
+	Credentials *FeedCredentialsInput `json:"credentials,omitempty"`
+	// Timeout in seconds of requests fetching the feed. Pass 0 to use the server's default.
+	FetchTimeoutSeconds *int32 `json:"fetchTimeoutSeconds,omitempty"`
+}
+
 // Represents a user in the system
 type User struct {
 	// Unique identifier for the user
@@ -57,3 +283,250 @@ type User struct {
 	// Username of the user
 	Username string `json:"username"`
 }
+
+// What an API token is allowed to do
+type APITokenScope string
+
+const (
+	// Only queries and subscriptions are allowed
+	APITokenScopeReadOnly APITokenScope = "READ_ONLY"
+	// Queries, subscriptions and mutations are allowed
+	APITokenScopeReadWrite APITokenScope = "READ_WRITE"
+)
+
+var AllAPITokenScope = []APITokenScope{
+	APITokenScopeReadOnly,
+	APITokenScopeReadWrite,
+}
+
+func (e APITokenScope) IsValid() bool {
+	switch e {
+	case APITokenScopeReadOnly, APITokenScopeReadWrite:
+		return true
+	}
+	return false
+}
+
+func (e APITokenScope) String() string {
+	return string(e)
+}
+
+func (e *APITokenScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APITokenScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApiTokenScope", str)
+	}
+	return nil
+}
+
+func (e APITokenScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *APITokenScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e APITokenScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How often a digest email is sent
+type DigestFrequency string
+
+const (
+	// Digest emails are disabled
+	DigestFrequencyOff DigestFrequency = "OFF"
+	// Send a digest once a day
+	DigestFrequencyDaily DigestFrequency = "DAILY"
+	// Send a digest once a week
+	DigestFrequencyWeekly DigestFrequency = "WEEKLY"
+)
+
+var AllDigestFrequency = []DigestFrequency{
+	DigestFrequencyOff,
+	DigestFrequencyDaily,
+	DigestFrequencyWeekly,
+}
+
+func (e DigestFrequency) IsValid() bool {
+	switch e {
+	case DigestFrequencyOff, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+func (e DigestFrequency) String() string {
+	return string(e)
+}
+
+func (e *DigestFrequency) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DigestFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DigestFrequency", str)
+	}
+	return nil
+}
+
+func (e DigestFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DigestFrequency) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DigestFrequency) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Which articles an output feed contains
+type OutputFeedSource string
+
+const (
+	// The user's starred articles
+	OutputFeedSourceStarred OutputFeedSource = "STARRED"
+	// Articles of a single feed
+	OutputFeedSourceFeed OutputFeedSource = "FEED"
+	// Articles of the feeds in a folder
+	OutputFeedSourceFolder OutputFeedSource = "FOLDER"
+	// Articles whose title contains the query
+	OutputFeedSourceSearch OutputFeedSource = "SEARCH"
+)
+
+var AllOutputFeedSource = []OutputFeedSource{
+	OutputFeedSourceStarred,
+	OutputFeedSourceFeed,
+	OutputFeedSourceFolder,
+	OutputFeedSourceSearch,
+}
+
+func (e OutputFeedSource) IsValid() bool {
+	switch e {
+	case OutputFeedSourceStarred, OutputFeedSourceFeed, OutputFeedSourceFolder, OutputFeedSourceSearch:
+		return true
+	}
+	return false
+}
+
+func (e OutputFeedSource) String() string {
+	return string(e)
+}
+
+func (e *OutputFeedSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OutputFeedSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OutputFeedSource", str)
+	}
+	return nil
+}
+
+func (e OutputFeedSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OutputFeedSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OutputFeedSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Status of a refresh job
+type RefreshJobStatus string
+
+const (
+	// The job waits for other jobs to finish
+	RefreshJobStatusQueued RefreshJobStatus = "QUEUED"
+	// The feeds of the job are being fetched
+	RefreshJobStatusRunning RefreshJobStatus = "RUNNING"
+	// All feeds of the job were fetched
+	RefreshJobStatusDone RefreshJobStatus = "DONE"
+	// Some feeds of the job could not be fetched
+	RefreshJobStatusFailed RefreshJobStatus = "FAILED"
+)
+
+var AllRefreshJobStatus = []RefreshJobStatus{
+	RefreshJobStatusQueued,
+	RefreshJobStatusRunning,
+	RefreshJobStatusDone,
+	RefreshJobStatusFailed,
+}
+
+func (e RefreshJobStatus) IsValid() bool {
+	switch e {
+	case RefreshJobStatusQueued, RefreshJobStatusRunning, RefreshJobStatusDone, RefreshJobStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e RefreshJobStatus) String() string {
+	return string(e)
+}
+
+func (e *RefreshJobStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RefreshJobStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RefreshJobStatus", str)
+	}
+	return nil
+}
+
+func (e RefreshJobStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RefreshJobStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RefreshJobStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
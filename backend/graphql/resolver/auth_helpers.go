@@ -19,6 +19,15 @@ func getUserIDFromContext(ctx context.Context) (int64, error) {
 	return userID, nil
 }
 
+// requireSession returns an error if the request is authenticated by an API token rather than a session.
+// It guards operations that would let a leaked token extend its own access.
+func requireSession(ctx context.Context) error {
+	if _, ok := appcontext.GetAPITokenScope(ctx); ok {
+		return fmt.Errorf("forbidden: this operation cannot be performed with an API token")
+	}
+	return nil
+}
+
 // Helper function to get Echo context from GraphQL context
 func getEchoContext(ctx context.Context) (echo.Context, error) {
 	echoCtx, ok := ctx.Value("echo").(echo.Context)
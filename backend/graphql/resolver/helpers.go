@@ -0,0 +1,161 @@
+package resolver
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"undef.ninja/x/feedaka/credential"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/graphql/model"
+)
+
+// Upper limit of the per-feed fetch timeout, so that a feed cannot hold a fetch slot for long
+const maxFetchTimeoutSeconds = 300
+
+var refreshJobStatuses = map[fetchqueue.Status]model.RefreshJobStatus{
+	fetchqueue.StatusQueued:  model.RefreshJobStatusQueued,
+	fetchqueue.StatusRunning: model.RefreshJobStatusRunning,
+	fetchqueue.StatusDone:    model.RefreshJobStatusDone,
+	fetchqueue.StatusFailed:  model.RefreshJobStatusFailed,
+}
+
+// stringOrEmpty converts an optional input field to a string. Unset fields convert to "".
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// nilIfEmpty converts a string to an optional field. "" converts to null.
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// fetchTimeoutToModel converts a stored per-feed timeout to the API value. 0 means the default and converts to nil.
+func fetchTimeoutToModel(seconds int64) *int32 {
+	if seconds <= 0 {
+		return nil
+	}
+	v := int32(seconds)
+	return &v
+}
+
+// folderIDToModel converts the folder of a feed to the API value. Feeds in no folder convert to nil.
+func folderIDToModel(id sql.NullInt64) *string {
+	if !id.Valid {
+		return nil
+	}
+	v := strconv.FormatInt(id.Int64, 10)
+	return &v
+}
+
+// credentialsFromInput converts the input to credentials. A nil input converts to nil credentials.
+func credentialsFromInput(input *model.FeedCredentialsInput) *credential.Credentials {
+	if input == nil {
+		return nil
+	}
+	c := &credential.Credentials{
+		Username:    stringOrEmpty(input.Username),
+		Password:    stringOrEmpty(input.Password),
+		BearerToken: stringOrEmpty(input.BearerToken),
+		Cookie:      stringOrEmpty(input.Cookie),
+	}
+	if len(input.Headers) > 0 {
+		c.Headers = make(map[string]string, len(input.Headers))
+		for _, h := range input.Headers {
+			c.Headers[h.Name] = h.Value
+		}
+	}
+	return c
+}
+
+func selectorsFromInput(input model.ScrapeSelectorsInput) feed.Selectors {
+	return feed.Selectors{
+		Item:    input.Item,
+		Title:   stringOrEmpty(input.Title),
+		Link:    stringOrEmpty(input.Link),
+		Date:    stringOrEmpty(input.Date),
+		Content: stringOrEmpty(input.Content),
+	}
+}
+
+func selectorsToModel(s feed.Selectors) *model.ScrapeSelectors {
+	return &model.ScrapeSelectors{
+		Item:    s.Item,
+		Title:   nilIfEmpty(s.Title),
+		Link:    nilIfEmpty(s.Link),
+		Date:    nilIfEmpty(s.Date),
+		Content: nilIfEmpty(s.Content),
+	}
+}
+
+func apiTokenToModel(t db.ApiToken) *model.APIToken {
+	result := &model.APIToken{
+		ID:        strconv.FormatInt(t.ID, 10),
+		Name:      t.Name,
+		Scope:     model.APITokenScope(strings.ToUpper(t.Scope)),
+		CreatedAt: t.CreatedAt,
+	}
+	if t.ExpiresAt.Valid {
+		result.ExpiresAt = &t.ExpiresAt.String
+	}
+	if t.LastUsedAt.Valid {
+		result.LastUsedAt = &t.LastUsedAt.String
+	}
+	return result
+}
+
+func folderToModel(f db.Folder) *model.Folder {
+	return &model.Folder{
+		ID:   strconv.FormatInt(f.ID, 10),
+		Name: f.Name,
+	}
+}
+
+func outputFeedToModel(of db.OutputFeed) *model.OutputFeed {
+	result := &model.OutputFeed{
+		ID:        strconv.FormatInt(of.ID, 10),
+		Title:     of.Title,
+		Source:    model.OutputFeedSource(strings.ToUpper(of.Source)),
+		Token:     of.Token,
+		CreatedAt: of.CreatedAt,
+	}
+	if of.FeedID.Valid {
+		feedID := strconv.FormatInt(of.FeedID.Int64, 10)
+		result.FeedID = &feedID
+	}
+	result.FolderID = folderIDToModel(of.FolderID)
+	if of.Query != "" {
+		result.Query = &of.Query
+	}
+	return result
+}
+
+func refreshJobToModel(job fetchqueue.Job) *model.RefreshJob {
+	feedIDs := make([]string, 0, len(job.FeedIDs))
+	for _, feedID := range job.FeedIDs {
+		feedIDs = append(feedIDs, strconv.FormatInt(feedID, 10))
+	}
+	result := &model.RefreshJob{
+		ID:           strconv.FormatInt(job.ID, 10),
+		Status:       refreshJobStatuses[job.Status],
+		FeedIds:      feedIDs,
+		FetchedCount: int32(job.Fetched),
+		FailedCount:  int32(job.Failed),
+		Error:        nilIfEmpty(job.Err),
+		CreatedAt:    job.CreatedAt.Format(time.RFC3339),
+	}
+	if !job.FinishedAt.IsZero() {
+		finishedAt := job.FinishedAt.Format(time.RFC3339)
+		result.FinishedAt = &finishedAt
+	}
+	return result
+}
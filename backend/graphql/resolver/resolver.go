@@ -1,10 +1,14 @@
 package resolver
 
 import (
-	"database/sql"
-
 	"undef.ninja/x/feedaka/auth"
+	"undef.ninja/x/feedaka/credential"
 	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/fetchqueue"
+	"undef.ninja/x/feedaka/imageproxy"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
 )
 
 // This file will not be regenerated automatically.
@@ -12,7 +16,12 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	DB            *sql.DB
-	Queries       *db.Queries
+	Queries       db.Store
 	SessionConfig *auth.SessionConfig
+	WebSub        *websub.Subscriber
+	PubSub        *pubsub.Bus
+	ImageProxy    *imageproxy.Proxy
+	Credentials   *credential.Store
+	Fetcher       *feed.Fetcher
+	FetchQueue    *fetchqueue.Queue
 }
@@ -6,88 +6,621 @@ package resolver
 
 import (
 	"context"
+	"crypto/md5"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
+	"net/mail"
 	"strconv"
+	"strings"
 	"time"
 
 	"undef.ninja/x/feedaka/auth"
 	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/digest"
+	"undef.ninja/x/feedaka/favicon"
 	"undef.ninja/x/feedaka/feed"
 	gql "undef.ninja/x/feedaka/graphql"
 	"undef.ninja/x/feedaka/graphql/model"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/output"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/sanitize"
 )
 
+// Content is the resolver for the content field.
+func (r *articleResolver) Content(ctx context.Context, obj *model.Article) (string, error) {
+	articleID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid article ID: %w", err)
+	}
+
+	// The parent resolver has already checked authorization
+	content, err := r.Queries.GetArticleContent(ctx, articleID)
+	if err != nil {
+		return "", fmt.Errorf("failed to query article content: %w", err)
+	}
+	// Content is sanitized when stored. Sanitize it again in case it was stored by an older version.
+	return r.ImageProxy.Rewrite(sanitize.HTML(content, obj.URL)), nil
+}
+
+// IconURL is the resolver for the iconUrl field.
+func (r *feedResolver) IconURL(ctx context.Context, obj *model.Feed) (*string, error) {
+	feedID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// The parent resolver has already checked authorization
+	updatedAt, err := r.Queries.GetFeedIconUpdatedAt(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query feed icon: %w", err)
+	}
+	iconURL := favicon.URL(feedID, updatedAt)
+	return &iconURL, nil
+}
+
+// ScrapeSelectors is the resolver for the scrapeSelectors field.
+func (r *feedResolver) ScrapeSelectors(ctx context.Context, obj *model.Feed) (*model.ScrapeSelectors, error) {
+	feedID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// The parent resolver has already checked authorization
+	selectors, err := feed.GetSelectors(ctx, r.Queries, feedID)
+	if err != nil {
+		return nil, err
+	}
+	if selectors == nil {
+		return nil, nil
+	}
+	return selectorsToModel(*selectors), nil
+}
+
+// HasCredentials is the resolver for the hasCredentials field.
+func (r *feedResolver) HasCredentials(ctx context.Context, obj *model.Feed) (bool, error) {
+	feedID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// The parent resolver has already checked authorization
+	return r.Credentials.Exists(ctx, feedID)
+}
+
+// Feeds is the resolver for the feeds field.
+func (r *folderResolver) Feeds(ctx context.Context, obj *model.Folder) ([]*model.Feed, error) {
+	folderID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid folder ID: %w", err)
+	}
+
+	dbFeeds, err := r.Queries.GetFeedsByFolder(ctx, sql.NullInt64{Int64: folderID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query feeds: %w", err)
+	}
+
+	feeds := make([]*model.Feed, 0, len(dbFeeds))
+	for _, dbFeed := range dbFeeds {
+		feeds = append(feeds, &model.Feed{
+			ID:                  strconv.FormatInt(dbFeed.ID, 10),
+			URL:                 dbFeed.Url,
+			Title:               dbFeed.Title,
+			FetchedAt:           dbFeed.FetchedAt,
+			IsSubscribed:        dbFeed.IsSubscribed == 1,
+			FetchFullContent:    dbFeed.FetchFullContent == 1,
+			FetchTimeoutSeconds: fetchTimeoutToModel(dbFeed.FetchTimeoutSeconds),
+			FolderID:            folderIDToModel(dbFeed.FolderID),
+		})
+	}
+
+	return feeds, nil
+}
+
 // AddFeed is the resolver for the addFeed field.
-func (r *mutationResolver) AddFeed(ctx context.Context, url string) (*model.Feed, error) {
+func (r *mutationResolver) AddFeed(ctx context.Context, url string, credentials *model.FeedCredentialsInput) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFeed, f, added, err := r.Fetcher.Add(ctx, r.Queries, userID, url, feed.AddOptions{
+		Credentials:     credentialsFromInput(credentials),
+		CredentialStore: r.Credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.PubSub.PublishFeedSynced(userID, dbFeed.ID, added)
+
+	// Subscribe to the WebSub hub if the feed advertises one
+	if err := r.WebSub.Subscribe(ctx, dbFeed.ID, dbFeed.Url, f); err != nil {
+		slog.WarnContext(ctx, "Failed to subscribe to WebSub hub", "feed_id", dbFeed.ID, "feed_url", logging.URL(dbFeed.Url), "error", err)
+	}
+
+	return &model.Feed{
+		ID:                  strconv.FormatInt(dbFeed.ID, 10),
+		URL:                 dbFeed.Url,
+		Title:               dbFeed.Title,
+		FetchedAt:           dbFeed.FetchedAt,
+		IsSubscribed:        dbFeed.IsSubscribed == 1,
+		FetchFullContent:    dbFeed.FetchFullContent == 1,
+		FetchTimeoutSeconds: fetchTimeoutToModel(dbFeed.FetchTimeoutSeconds),
+		FolderID:            folderIDToModel(dbFeed.FolderID),
+	}, nil
+}
+
+// AddScrapedFeed is the resolver for the addScrapedFeed field.
+func (r *mutationResolver) AddScrapedFeed(ctx context.Context, url string, selectors model.ScrapeSelectorsInput, credentials *model.FeedCredentialsInput) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scrapeSelectors := selectorsFromInput(selectors)
+	dbFeed, _, added, err := r.Fetcher.Add(ctx, r.Queries, userID, url, feed.AddOptions{
+		Selectors:       &scrapeSelectors,
+		Credentials:     credentialsFromInput(credentials),
+		CredentialStore: r.Credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.PubSub.PublishFeedSynced(userID, dbFeed.ID, added)
+
+	return &model.Feed{
+		ID:                  strconv.FormatInt(dbFeed.ID, 10),
+		URL:                 dbFeed.Url,
+		Title:               dbFeed.Title,
+		FetchedAt:           dbFeed.FetchedAt,
+		IsSubscribed:        dbFeed.IsSubscribed == 1,
+		FetchFullContent:    dbFeed.FetchFullContent == 1,
+		FetchTimeoutSeconds: fetchTimeoutToModel(dbFeed.FetchTimeoutSeconds),
+		FolderID:            folderIDToModel(dbFeed.FolderID),
+	}, nil
+}
+
+// UnsubscribeFeed is the resolver for the unsubscribeFeed field.
+func (r *mutationResolver) UnsubscribeFeed(ctx context.Context, id string) (bool, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, fmt.Errorf("feed not found")
+		}
+		return false, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return false, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	err = r.Queries.UnsubscribeFeed(ctx, feed.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to unsubscribe from feed: %w", err)
+	}
+
+	// Stop receiving pushes for the feed
+	if err := r.WebSub.Unsubscribe(ctx, feed.ID); err != nil {
+		slog.WarnContext(ctx, "Failed to unsubscribe from WebSub hub", "feed_id", feed.ID, "feed_url", logging.URL(feed.Url), "error", err)
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+
+	return true, nil
+}
+
+// UpdateFeed is the resolver for the updateFeed field.
+func (r *mutationResolver) UpdateFeed(ctx context.Context, id string, input model.UpdateFeedInput) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feed not found")
+		}
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	// Validate input, so that an invalid field leaves the feed unchanged
+	credentials := credentialsFromInput(input.Credentials)
+	if !credentials.IsEmpty() {
+		if err := credentials.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if input.FetchTimeoutSeconds != nil {
+		seconds := *input.FetchTimeoutSeconds
+		if seconds < 0 || seconds > maxFetchTimeoutSeconds {
+			return nil, fmt.Errorf("fetch timeout must be between 0 and %d seconds", maxFetchTimeoutSeconds)
+		}
+	}
+
+	err = r.Queries.InTx(ctx, func(qtx db.Store) error {
+		if input.FetchFullContent != nil {
+			fetchFullContent := int64(0)
+			if *input.FetchFullContent {
+				fetchFullContent = 1
+			}
+			err := qtx.UpdateFeedFetchFullContent(ctx, db.UpdateFeedFetchFullContentParams{
+				FetchFullContent: fetchFullContent,
+				ID:               feed.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update feed: %w", err)
+			}
+		}
+
+		if input.Credentials != nil {
+			if err := r.Credentials.With(qtx).Set(ctx, feed.ID, credentials); err != nil {
+				return err
+			}
+		}
+
+		if input.FetchTimeoutSeconds != nil {
+			err := qtx.UpdateFeedFetchTimeout(ctx, db.UpdateFeedFetchTimeoutParams{
+				FetchTimeoutSeconds: int64(*input.FetchTimeoutSeconds),
+				ID:                  feed.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update feed: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+
+	// Fetch the updated feed
+	return r.Query().Feed(ctx, id)
+}
+
+// SetFeedFolder is the resolver for the setFeedFolder field.
+func (r *mutationResolver) SetFeedFolder(ctx context.Context, id string, folderID *string) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feed not found")
+		}
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	var dbFolderID sql.NullInt64
+	if folderID != nil {
+		fid, err := strconv.ParseInt(*folderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid folder ID: %w", err)
+		}
+		folder, err := r.Queries.GetFolder(ctx, fid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("folder not found")
+			}
+			return nil, fmt.Errorf("failed to query folder: %w", err)
+		}
+		if folder.UserID != userID {
+			return nil, fmt.Errorf("forbidden: you don't have access to this folder")
+		}
+		dbFolderID = sql.NullInt64{Int64: folder.ID, Valid: true}
+	}
+
+	err = r.Queries.UpdateFeedFolder(ctx, db.UpdateFeedFolderParams{
+		FolderID: dbFolderID,
+		ID:       feed.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update feed: %w", err)
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+
+	// Fetch the updated feed
+	return r.Query().Feed(ctx, id)
+}
+
+// CreateFolder is the resolver for the createFolder field.
+func (r *mutationResolver) CreateFolder(ctx context.Context, name string) (*model.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	_, err = r.Queries.GetFolderByName(ctx, db.GetFolderByNameParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err == nil {
+		return nil, fmt.Errorf("folder already exists: %s", name)
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query folder: %w", err)
+	}
+
+	folder, err := r.Queries.CreateFolder(ctx, db.CreateFolderParams{
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert folder: %w", err)
+	}
+
+	return folderToModel(folder), nil
+}
+
+// RenameFolder is the resolver for the renameFolder field.
+func (r *mutationResolver) RenameFolder(ctx context.Context, id string, name string) (*model.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid folder ID: %w", err)
+	}
+
+	// Fetch folder
+	folder, err := r.Queries.GetFolder(ctx, folderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, fmt.Errorf("failed to query folder: %w", err)
+	}
+
+	// Check authorization
+	if folder.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this folder")
+	}
+
+	// Validate input
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	existing, err := r.Queries.GetFolderByName(ctx, db.GetFolderByNameParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err == nil && existing.ID != folder.ID {
+		return nil, fmt.Errorf("folder already exists: %s", name)
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query folder: %w", err)
+	}
+
+	err = r.Queries.RenameFolder(ctx, db.RenameFolderParams{
+		Name: name,
+		ID:   folder.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename folder: %w", err)
+	}
+
+	folder.Name = name
+	return folderToModel(folder), nil
+}
+
+// DeleteFolder is the resolver for the deleteFolder field.
+func (r *mutationResolver) DeleteFolder(ctx context.Context, id string) (bool, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	folderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid folder ID: %w", err)
+	}
+
+	// Fetch folder
+	folder, err := r.Queries.GetFolder(ctx, folderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, fmt.Errorf("folder not found")
+		}
+		return false, fmt.Errorf("failed to query folder: %w", err)
+	}
+
+	// Check authorization
+	if folder.UserID != userID {
+		return false, fmt.Errorf("forbidden: you don't have access to this folder")
+	}
+
+	feeds, err := r.Queries.GetFeedsByFolder(ctx, sql.NullInt64{Int64: folder.ID, Valid: true})
+	if err != nil {
+		return false, fmt.Errorf("failed to query feeds: %w", err)
+	}
+
+	// The feeds are kept and only taken out of the folder
+	err = r.Queries.InTx(ctx, func(qtx db.Store) error {
+		if err := qtx.ClearFeedsFolder(ctx, sql.NullInt64{Int64: folder.ID, Valid: true}); err != nil {
+			return fmt.Errorf("failed to update feeds: %w", err)
+		}
+		if err := qtx.DeleteOutputFeedsByFolder(ctx, sql.NullInt64{Int64: folder.ID, Valid: true}); err != nil {
+			return fmt.Errorf("failed to delete output feeds: %w", err)
+		}
+		if err := qtx.DeleteDigestFoldersByFolder(ctx, folder.ID); err != nil {
+			return fmt.Errorf("failed to update digest folders: %w", err)
+		}
+		if err := qtx.DeleteFolder(ctx, folder.ID); err != nil {
+			return fmt.Errorf("failed to delete folder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, feed := range feeds {
+		r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+	}
+
+	return true, nil
+}
+
+// RefreshFeed is the resolver for the refreshFeed field.
+func (r *mutationResolver) RefreshFeed(ctx context.Context, id string) (*model.RefreshJob, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feed not found")
+		}
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	job, err := r.FetchQueue.RefreshFeed(userID, feed.ID)
+	if err != nil {
+		return nil, err
+	}
+	return refreshJobToModel(job), nil
+}
+
+// RefreshFolder is the resolver for the refreshFolder field.
+func (r *mutationResolver) RefreshFolder(ctx context.Context, id string) (*model.RefreshJob, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch the feed to get its title
-	f, err := feed.Fetch(ctx, url)
+	folderID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse feed: %w", err)
+		return nil, fmt.Errorf("invalid folder ID: %w", err)
 	}
 
-	// Insert the feed into the database
-	dbFeed, err := r.Queries.CreateFeed(ctx, db.CreateFeedParams{
-		Url:       url,
-		Title:     f.Title,
-		FetchedAt: time.Now().UTC().Format(time.RFC3339),
-		UserID:    userID,
-	})
+	// Fetch folder
+	folder, err := r.Queries.GetFolder(ctx, folderID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert feed: %w", err)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, fmt.Errorf("failed to query folder: %w", err)
 	}
 
-	// Sync articles from the feed
-	if err := feed.Sync(ctx, r.Queries, dbFeed.ID, f); err != nil {
-		return nil, fmt.Errorf("failed to sync articles: %w", err)
+	// Check authorization
+	if folder.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this folder")
 	}
 
-	return &model.Feed{
-		ID:           strconv.FormatInt(dbFeed.ID, 10),
-		URL:          dbFeed.Url,
-		Title:        dbFeed.Title,
-		FetchedAt:    dbFeed.FetchedAt,
-		IsSubscribed: dbFeed.IsSubscribed == 1,
-	}, nil
+	job, err := r.FetchQueue.RefreshFolder(ctx, userID, folder.ID)
+	if err != nil {
+		return nil, err
+	}
+	return refreshJobToModel(job), nil
 }
 
-// UnsubscribeFeed is the resolver for the unsubscribeFeed field.
-func (r *mutationResolver) UnsubscribeFeed(ctx context.Context, id string) (bool, error) {
+// RefreshAll is the resolver for the refreshAll field.
+func (r *mutationResolver) RefreshAll(ctx context.Context) (*model.RefreshJob, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	feedID, err := strconv.ParseInt(id, 10, 64)
+	job, err := r.FetchQueue.RefreshAll(ctx, userID)
 	if err != nil {
-		return false, fmt.Errorf("invalid feed ID: %w", err)
+		return nil, err
 	}
+	return refreshJobToModel(job), nil
+}
 
-	// Fetch feed
-	feed, err := r.Queries.GetFeed(ctx, feedID)
+// RefetchArticleContent is the resolver for the refetchArticleContent field.
+func (r *mutationResolver) RefetchArticleContent(ctx context.Context, id string) (*model.Article, error) {
+	// Fetch article and check authorization
+	article, err := r.Query().Article(ctx, id)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return false, fmt.Errorf("feed not found")
-		}
-		return false, fmt.Errorf("failed to query feed: %w", err)
+		return nil, err
+	}
+	articleID, err := strconv.ParseInt(article.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid article ID: %w", err)
 	}
 
-	// Check authorization
-	if feed.UserID != userID {
-		return false, fmt.Errorf("forbidden: you don't have access to this feed")
+	content, err := r.Fetcher.FetchFullContent(ctx, article.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch full content: %w", err)
 	}
 
-	err = r.Queries.UnsubscribeFeed(ctx, feed.ID)
+	err = r.Queries.UpdateArticleContent(ctx, db.UpdateArticleContentParams{
+		Content: content,
+		ID:      articleID,
+	})
 	if err != nil {
-		return false, fmt.Errorf("failed to unsubscribe from feed: %w", err)
+		return nil, fmt.Errorf("failed to update article content: %w", err)
 	}
 
-	return true, nil
+	return article, nil
 }
 
 // MarkArticleRead is the resolver for the markArticleRead field.
@@ -129,6 +662,9 @@ func (r *mutationResolver) MarkArticleRead(ctx context.Context, id string) (*mod
 		return nil, fmt.Errorf("failed to mark article as read: %w", err)
 	}
 
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: article.FeedID})
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+
 	// Fetch the updated article
 	return r.Query().Article(ctx, id)
 }
@@ -169,131 +705,497 @@ func (r *mutationResolver) MarkArticleUnread(ctx context.Context, id string) (*m
 		ID:     article.ID,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to mark article as unread: %w", err)
+		return nil, fmt.Errorf("failed to mark article as unread: %w", err)
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: article.FeedID})
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+
+	// Fetch the updated article
+	return r.Query().Article(ctx, id)
+}
+
+// MarkFeedRead is the resolver for the markFeedRead field.
+func (r *mutationResolver) MarkFeedRead(ctx context.Context, id string) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feed not found")
+		}
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	// Update all articles in the feed to be read
+	err = r.Queries.MarkFeedArticlesRead(ctx, feed.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark feed as read: %w", err)
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+
+	// Fetch the updated feed
+	return r.Query().Feed(ctx, id)
+}
+
+// MarkFeedUnread is the resolver for the markFeedUnread field.
+func (r *mutationResolver) MarkFeedUnread(ctx context.Context, id string) (*model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	}
+
+	// Fetch feed
+	feed, err := r.Queries.GetFeed(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feed not found")
+		}
+		return nil, fmt.Errorf("failed to query feed: %w", err)
+	}
+
+	// Check authorization
+	if feed.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	}
+
+	// Update all articles in the feed to be unread
+	err = r.Queries.MarkFeedArticlesUnread(ctx, feed.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark feed as unread: %w", err)
+	}
+
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feed.ID})
+	r.PubSub.Publish(userID, pubsub.Event{Type: pubsub.UnreadCountChanged})
+
+	// Fetch the updated feed
+	return r.Query().Feed(ctx, id)
+}
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, username string, password string) (*model.AuthPayload, error) {
+	// Verify user credentials
+	user, err := r.Queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("invalid credentials")
+		}
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	// Verify password. Disabled users get the same error so that it does not reveal the password is correct.
+	if !auth.VerifyPassword(user.PasswordHash, password) || user.Disabled != 0 {
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	// Get Echo context to create session
+	echoCtx, err := getEchoContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get echo context: %w", err)
+	}
+
+	// Create session and store user ID
+	if err := r.SessionConfig.SetUserID(echoCtx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return &model.AuthPayload{
+		User: &model.User{
+			ID:       strconv.FormatInt(user.ID, 10),
+			Username: user.Username,
+		},
+	}, nil
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	// Get Echo context to destroy session
+	echoCtx, err := getEchoContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get echo context: %w", err)
+	}
+
+	// Destroy session
+	if err := r.SessionConfig.DestroySession(echoCtx); err != nil {
+		return false, fmt.Errorf("failed to destroy session: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateDigestSettings is the resolver for the updateDigestSettings field.
+func (r *mutationResolver) UpdateDigestSettings(ctx context.Context, input model.DigestSettingsInput) (*model.DigestSettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	email, err := mail.ParseAddress(input.Email)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email address: %w", err)
+	}
+	frequency := strings.ToLower(string(input.Frequency))
+	if !digest.IsValidFrequency(frequency) {
+		return nil, fmt.Errorf("invalid digest frequency: %s", input.Frequency)
+	}
+	if input.MinArticles < 1 {
+		return nil, fmt.Errorf("minArticles must be at least 1")
+	}
+
+	// Check authorization of included feeds
+	feedIDs := make([]int64, 0, len(input.FeedIds))
+	for _, id := range input.FeedIds {
+		feedID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid feed ID: %w", err)
+		}
+		feed, err := r.Queries.GetFeed(ctx, feedID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("feed not found")
+			}
+			return nil, fmt.Errorf("failed to query feed: %w", err)
+		}
+		if feed.UserID != userID {
+			return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+		}
+		feedIDs = append(feedIDs, feedID)
+	}
+
+	// Check authorization of included folders
+	folderIDs := make([]int64, 0, len(input.FolderIds))
+	for _, id := range input.FolderIds {
+		folderID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid folder ID: %w", err)
+		}
+		folder, err := r.Queries.GetFolder(ctx, folderID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("folder not found")
+			}
+			return nil, fmt.Errorf("failed to query folder: %w", err)
+		}
+		if folder.UserID != userID {
+			return nil, fmt.Errorf("forbidden: you don't have access to this folder")
+		}
+		folderIDs = append(folderIDs, folderID)
+	}
+
+	err = r.Queries.InTx(ctx, func(qtx db.Store) error {
+		prev, err := qtx.GetDigestSettings(ctx, userID)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to query digest settings: %w", err)
+		}
+		enabled := frequency != digest.FrequencyOff && (err == sql.ErrNoRows || prev.Frequency == digest.FrequencyOff)
+
+		err = qtx.UpsertDigestSettings(ctx, db.UpsertDigestSettingsParams{
+			UserID:      userID,
+			Email:       email.Address,
+			Frequency:   frequency,
+			MinArticles: int64(input.MinArticles),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update digest settings: %w", err)
+		}
+
+		// A digest that is turned on starts at the newest article, so that its first email does not list the
+		// whole backlog
+		if enabled {
+			err = qtx.StartDigestAtLatestArticle(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("failed to update digest settings: %w", err)
+			}
+		}
+
+		err = qtx.DeleteDigestFeeds(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to update digest feeds: %w", err)
+		}
+		for _, feedID := range feedIDs {
+			err = qtx.AddDigestFeed(ctx, db.AddDigestFeedParams{
+				UserID: userID,
+				FeedID: feedID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update digest feeds: %w", err)
+			}
+		}
+
+		err = qtx.DeleteDigestFolders(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to update digest folders: %w", err)
+		}
+		for _, folderID := range folderIDs {
+			err = qtx.AddDigestFolder(ctx, db.AddDigestFolderParams{
+				UserID:   userID,
+				FolderID: folderID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update digest folders: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the updated settings
+	return r.Query().DigestSettings(ctx)
+}
+
+// SetFeverPassword is the resolver for the setFeverPassword field.
+func (r *mutationResolver) SetFeverPassword(ctx context.Context, password *string) (bool, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := requireSession(ctx); err != nil {
+		return false, err
+	}
+
+	user, err := r.Queries.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	var apiKey sql.NullString
+	if password != nil {
+		// Fever clients send md5("username:password"), so require a strong password
+		if len(*password) < 15 {
+			return false, fmt.Errorf("password must be at least 15 characters long")
+		}
+		sum := md5.Sum([]byte(user.Username + ":" + *password))
+		apiKey = sql.NullString{String: hex.EncodeToString(sum[:]), Valid: true}
+	}
+
+	err = r.Queries.UpdateUserFeverAPIKey(ctx, db.UpdateUserFeverAPIKeyParams{
+		FeverApiKey: apiKey,
+		ID:          userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update Fever API key: %w", err)
+	}
+
+	return true, nil
+}
+
+// CreateAPIToken is the resolver for the createApiToken field.
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, input model.CreateAPITokenInput) (*model.CreateAPITokenPayload, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireSession(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	scope := strings.ToLower(string(input.Scope))
+	if !auth.IsValidScope(scope) {
+		return nil, fmt.Errorf("invalid API token scope: %s", input.Scope)
+	}
+	now := time.Now().UTC()
+	var expiresAt sql.NullString
+	if input.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *input.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiresAt: %w", err)
+		}
+		if !t.After(now) {
+			return nil, fmt.Errorf("expiresAt must be in the future")
+		}
+		expiresAt = sql.NullString{String: t.UTC().Format(time.RFC3339), Valid: true}
+	}
+
+	token, hash, err := auth.GenerateAPIToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API token: %w", err)
+	}
+	apiToken, err := r.Queries.CreateAPIToken(ctx, db.CreateAPITokenParams{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hash,
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+		CreatedAt: now.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert API token: %w", err)
 	}
 
-	// Fetch the updated article
-	return r.Query().Article(ctx, id)
+	return &model.CreateAPITokenPayload{
+		APIToken: apiTokenToModel(apiToken),
+		Token:    token,
+	}, nil
 }
 
-// MarkFeedRead is the resolver for the markFeedRead field.
-func (r *mutationResolver) MarkFeedRead(ctx context.Context, id string) (*model.Feed, error) {
+// RevokeAPIToken is the resolver for the revokeApiToken field.
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, id string) (bool, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-
-	feedID, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	if err := requireSession(ctx); err != nil {
+		return false, err
 	}
 
-	// Fetch feed
-	feed, err := r.Queries.GetFeed(ctx, feedID)
+	tokenID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("feed not found")
-		}
-		return nil, fmt.Errorf("failed to query feed: %w", err)
-	}
-
-	// Check authorization
-	if feed.UserID != userID {
-		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+		return false, fmt.Errorf("invalid API token ID: %w", err)
 	}
 
-	// Update all articles in the feed to be read
-	err = r.Queries.MarkFeedArticlesRead(ctx, feed.ID)
+	// Only the user's own tokens are deleted
+	n, err := r.Queries.DeleteAPIToken(ctx, db.DeleteAPITokenParams{
+		ID:     tokenID,
+		UserID: userID,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to mark feed as read: %w", err)
+		return false, fmt.Errorf("failed to delete API token: %w", err)
+	}
+	if n == 0 {
+		return false, fmt.Errorf("API token not found")
 	}
 
-	// Fetch the updated feed
-	return r.Query().Feed(ctx, id)
+	return true, nil
 }
 
-// MarkFeedUnread is the resolver for the markFeedUnread field.
-func (r *mutationResolver) MarkFeedUnread(ctx context.Context, id string) (*model.Feed, error) {
+// CreateOutputFeed is the resolver for the createOutputFeed field.
+func (r *mutationResolver) CreateOutputFeed(ctx context.Context, input model.CreateOutputFeedInput) (*model.OutputFeed, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	feedID, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("invalid feed ID: %w", err)
+	// Validate input
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title must not be empty")
 	}
-
-	// Fetch feed
-	feed, err := r.Queries.GetFeed(ctx, feedID)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("feed not found")
+	source := strings.ToLower(string(input.Source))
+	if !output.IsValidSource(source) {
+		return nil, fmt.Errorf("invalid output feed source: %s", input.Source)
+	}
+	var feedID, folderID sql.NullInt64
+	var query string
+	switch source {
+	case output.SourceFeed:
+		if input.FeedID == nil {
+			return nil, fmt.Errorf("feedId is required for FEED output feeds")
+		}
+		id, err := strconv.ParseInt(*input.FeedID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid feed ID: %w", err)
+		}
+		feed, err := r.Queries.GetFeed(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("feed not found")
+			}
+			return nil, fmt.Errorf("failed to query feed: %w", err)
+		}
+		if feed.UserID != userID {
+			return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+		}
+		feedID = sql.NullInt64{Int64: feed.ID, Valid: true}
+	case output.SourceFolder:
+		if input.FolderID == nil {
+			return nil, fmt.Errorf("folderId is required for FOLDER output feeds")
+		}
+		id, err := strconv.ParseInt(*input.FolderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid folder ID: %w", err)
+		}
+		folder, err := r.Queries.GetFolder(ctx, id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("folder not found")
+			}
+			return nil, fmt.Errorf("failed to query folder: %w", err)
+		}
+		if folder.UserID != userID {
+			return nil, fmt.Errorf("forbidden: you don't have access to this folder")
+		}
+		folderID = sql.NullInt64{Int64: folder.ID, Valid: true}
+	case output.SourceSearch:
+		if input.Query != nil {
+			query = strings.TrimSpace(*input.Query)
+		}
+		if query == "" {
+			return nil, fmt.Errorf("query is required for SEARCH output feeds")
 		}
-		return nil, fmt.Errorf("failed to query feed: %w", err)
 	}
 
-	// Check authorization
-	if feed.UserID != userID {
-		return nil, fmt.Errorf("forbidden: you don't have access to this feed")
+	token, err := output.GenerateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
-
-	// Update all articles in the feed to be unread
-	err = r.Queries.MarkFeedArticlesUnread(ctx, feed.ID)
+	of, err := r.Queries.CreateOutputFeed(ctx, db.CreateOutputFeedParams{
+		UserID:    userID,
+		Token:     token,
+		Title:     title,
+		Source:    source,
+		FeedID:    feedID,
+		FolderID:  folderID,
+		Query:     query,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to mark feed as unread: %w", err)
+		return nil, fmt.Errorf("failed to insert output feed: %w", err)
 	}
 
-	// Fetch the updated feed
-	return r.Query().Feed(ctx, id)
+	return outputFeedToModel(of), nil
 }
 
-// Login is the resolver for the login field.
-func (r *mutationResolver) Login(ctx context.Context, username string, password string) (*model.AuthPayload, error) {
-	// Verify user credentials
-	user, err := r.Queries.GetUserByUsername(ctx, username)
+// DeleteOutputFeed is the resolver for the deleteOutputFeed field.
+func (r *mutationResolver) DeleteOutputFeed(ctx context.Context, id string) (bool, error) {
+	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("invalid credentials")
-		}
-		return nil, fmt.Errorf("failed to query user: %w", err)
-	}
-
-	// Verify password
-	if !auth.VerifyPassword(user.PasswordHash, password) {
-		return nil, fmt.Errorf("invalid credentials")
+		return false, err
 	}
 
-	// Get Echo context to create session
-	echoCtx, err := getEchoContext(ctx)
+	outputFeedID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get echo context: %w", err)
-	}
-
-	// Create session and store user ID
-	if err := r.SessionConfig.SetUserID(echoCtx, user.ID); err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+		return false, fmt.Errorf("invalid output feed ID: %w", err)
 	}
 
-	return &model.AuthPayload{
-		User: &model.User{
-			ID:       strconv.FormatInt(user.ID, 10),
-			Username: user.Username,
-		},
-	}, nil
-}
-
-// Logout is the resolver for the logout field.
-func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
-	// Get Echo context to destroy session
-	echoCtx, err := getEchoContext(ctx)
+	// Only the user's own output feeds are deleted
+	n, err := r.Queries.DeleteOutputFeed(ctx, db.DeleteOutputFeedParams{
+		ID:     outputFeedID,
+		UserID: userID,
+	})
 	if err != nil {
-		return false, fmt.Errorf("failed to get echo context: %w", err)
+		return false, fmt.Errorf("failed to delete output feed: %w", err)
 	}
-
-	// Destroy session
-	if err := r.SessionConfig.DestroySession(echoCtx); err != nil {
-		return false, fmt.Errorf("failed to destroy session: %w", err)
+	if n == 0 {
+		return false, fmt.Errorf("output feed not found")
 	}
 
 	return true, nil
@@ -314,11 +1216,14 @@ func (r *queryResolver) Feeds(ctx context.Context) ([]*model.Feed, error) {
 	var feeds []*model.Feed
 	for _, dbFeed := range dbFeeds {
 		feeds = append(feeds, &model.Feed{
-			ID:           strconv.FormatInt(dbFeed.ID, 10),
-			URL:          dbFeed.Url,
-			Title:        dbFeed.Title,
-			FetchedAt:    dbFeed.FetchedAt,
-			IsSubscribed: dbFeed.IsSubscribed == 1,
+			ID:                  strconv.FormatInt(dbFeed.ID, 10),
+			URL:                 dbFeed.Url,
+			Title:               dbFeed.Title,
+			FetchedAt:           dbFeed.FetchedAt,
+			IsSubscribed:        dbFeed.IsSubscribed == 1,
+			FetchFullContent:    dbFeed.FetchFullContent == 1,
+			FetchTimeoutSeconds: fetchTimeoutToModel(dbFeed.FetchTimeoutSeconds),
+			FolderID:            folderIDToModel(dbFeed.FolderID),
 		})
 	}
 
@@ -347,10 +1252,13 @@ func (r *queryResolver) UnreadArticles(ctx context.Context) ([]*model.Article, e
 			URL:    row.Url,
 			IsRead: row.IsRead == 1,
 			Feed: &model.Feed{
-				ID:           strconv.FormatInt(row.FeedID2, 10),
-				URL:          row.FeedUrl,
-				Title:        row.FeedTitle,
-				IsSubscribed: row.FeedIsSubscribed == 1,
+				ID:                  strconv.FormatInt(row.FeedID2, 10),
+				URL:                 row.FeedUrl,
+				Title:               row.FeedTitle,
+				IsSubscribed:        row.FeedIsSubscribed == 1,
+				FetchFullContent:    row.FeedFetchFullContent == 1,
+				FetchTimeoutSeconds: fetchTimeoutToModel(row.FeedFetchTimeoutSeconds),
+				FolderID:            folderIDToModel(row.FeedFolderID),
 			},
 		})
 	}
@@ -380,10 +1288,13 @@ func (r *queryResolver) ReadArticles(ctx context.Context) ([]*model.Article, err
 			URL:    row.Url,
 			IsRead: row.IsRead == 1,
 			Feed: &model.Feed{
-				ID:           strconv.FormatInt(row.FeedID2, 10),
-				URL:          row.FeedUrl,
-				Title:        row.FeedTitle,
-				IsSubscribed: row.FeedIsSubscribed == 1,
+				ID:                  strconv.FormatInt(row.FeedID2, 10),
+				URL:                 row.FeedUrl,
+				Title:               row.FeedTitle,
+				IsSubscribed:        row.FeedIsSubscribed == 1,
+				FetchFullContent:    row.FeedFetchFullContent == 1,
+				FetchTimeoutSeconds: fetchTimeoutToModel(row.FeedFetchTimeoutSeconds),
+				FolderID:            folderIDToModel(row.FeedFolderID),
 			},
 		})
 	}
@@ -418,11 +1329,14 @@ func (r *queryResolver) Feed(ctx context.Context, id string) (*model.Feed, error
 	}
 
 	return &model.Feed{
-		ID:           strconv.FormatInt(dbFeed.ID, 10),
-		URL:          dbFeed.Url,
-		Title:        dbFeed.Title,
-		FetchedAt:    dbFeed.FetchedAt,
-		IsSubscribed: dbFeed.IsSubscribed == 1,
+		ID:                  strconv.FormatInt(dbFeed.ID, 10),
+		URL:                 dbFeed.Url,
+		Title:               dbFeed.Title,
+		FetchedAt:           dbFeed.FetchedAt,
+		IsSubscribed:        dbFeed.IsSubscribed == 1,
+		FetchFullContent:    dbFeed.FetchFullContent == 1,
+		FetchTimeoutSeconds: fetchTimeoutToModel(dbFeed.FetchTimeoutSeconds),
+		FolderID:            folderIDToModel(dbFeed.FolderID),
 	}, nil
 }
 
@@ -467,13 +1381,65 @@ func (r *queryResolver) Article(ctx context.Context, id string) (*model.Article,
 		URL:    row.Url,
 		IsRead: row.IsRead == 1,
 		Feed: &model.Feed{
-			ID:    strconv.FormatInt(row.FeedID2, 10),
-			URL:   row.FeedUrl,
-			Title: row.FeedTitle,
+			ID:                  strconv.FormatInt(row.FeedID2, 10),
+			URL:                 row.FeedUrl,
+			Title:               row.FeedTitle,
+			FetchFullContent:    row.FeedFetchFullContent == 1,
+			FetchTimeoutSeconds: fetchTimeoutToModel(row.FeedFetchTimeoutSeconds),
+			FolderID:            folderIDToModel(row.FeedFolderID),
 		},
 	}, nil
 }
 
+// Folders is the resolver for the folders field.
+func (r *queryResolver) Folders(ctx context.Context) ([]*model.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFolders, err := r.Queries.GetFolders(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query folders: %w", err)
+	}
+
+	folders := make([]*model.Folder, 0, len(dbFolders))
+	for _, folder := range dbFolders {
+		folders = append(folders, folderToModel(folder))
+	}
+
+	return folders, nil
+}
+
+// Folder is the resolver for the folder field.
+func (r *queryResolver) Folder(ctx context.Context, id string) (*model.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid folder ID: %w", err)
+	}
+
+	// Fetch folder
+	folder, err := r.Queries.GetFolder(ctx, folderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, fmt.Errorf("failed to query folder: %w", err)
+	}
+
+	// Check authorization
+	if folder.UserID != userID {
+		return nil, fmt.Errorf("forbidden: you don't have access to this folder")
+	}
+
+	return folderToModel(folder), nil
+}
+
 // CurrentUser is the resolver for the currentUser field.
 func (r *queryResolver) CurrentUser(ctx context.Context) (*model.User, error) {
 	userID, err := getUserIDFromContext(ctx)
@@ -496,11 +1462,339 @@ func (r *queryResolver) CurrentUser(ctx context.Context) (*model.User, error) {
 	}, nil
 }
 
+// DigestSettings is the resolver for the digestSettings field.
+func (r *queryResolver) DigestSettings(ctx context.Context) (*model.DigestSettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := r.Queries.GetDigestSettings(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// Not configured yet
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query digest settings: %w", err)
+	}
+
+	dbFeedIDs, err := r.Queries.GetDigestFeedIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query digest feeds: %w", err)
+	}
+	feedIDs := make([]string, 0, len(dbFeedIDs))
+	for _, feedID := range dbFeedIDs {
+		feedIDs = append(feedIDs, strconv.FormatInt(feedID, 10))
+	}
+
+	dbFolderIDs, err := r.Queries.GetDigestFolderIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query digest folders: %w", err)
+	}
+	folderIDs := make([]string, 0, len(dbFolderIDs))
+	for _, folderID := range dbFolderIDs {
+		folderIDs = append(folderIDs, strconv.FormatInt(folderID, 10))
+	}
+
+	return &model.DigestSettings{
+		Email:       settings.Email,
+		Frequency:   model.DigestFrequency(strings.ToUpper(settings.Frequency)),
+		MinArticles: int32(settings.MinArticles),
+		FeedIds:     feedIDs,
+		FolderIds:   folderIDs,
+	}, nil
+}
+
+// APITokens is the resolver for the apiTokens field.
+func (r *queryResolver) APITokens(ctx context.Context) ([]*model.APIToken, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTokens, err := r.Queries.GetAPITokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API tokens: %w", err)
+	}
+
+	tokens := make([]*model.APIToken, 0, len(dbTokens))
+	for _, t := range dbTokens {
+		tokens = append(tokens, apiTokenToModel(t))
+	}
+	return tokens, nil
+}
+
+// OutputFeeds is the resolver for the outputFeeds field.
+func (r *queryResolver) OutputFeeds(ctx context.Context) ([]*model.OutputFeed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dbOutputFeeds, err := r.Queries.GetOutputFeeds(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query output feeds: %w", err)
+	}
+
+	outputFeeds := make([]*model.OutputFeed, 0, len(dbOutputFeeds))
+	for _, of := range dbOutputFeeds {
+		outputFeeds = append(outputFeeds, outputFeedToModel(of))
+	}
+	return outputFeeds, nil
+}
+
+// PreviewScrapedFeed is the resolver for the previewScrapedFeed field.
+func (r *queryResolver) PreviewScrapedFeed(ctx context.Context, url string, selectors model.ScrapeSelectorsInput, credentials *model.FeedCredentialsInput) (*model.ScrapedFeedPreview, error) {
+	if _, err := getUserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	// The page is shown to the user before they subscribe, so it must not be read from the local network
+	f, err := r.Fetcher.Scrape(ctx, url, selectorsFromInput(selectors), feed.RequestOptions{
+		Credentials: credentialsFromInput(credentials),
+		Public:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.ScrapedItem, 0, len(f.Items))
+	for _, item := range f.Items {
+		baseURL := item.Link
+		if baseURL == "" {
+			baseURL = f.Link
+		}
+		scraped := &model.ScrapedItem{
+			Title:   item.Title,
+			URL:     item.Link,
+			Content: r.ImageProxy.Rewrite(sanitize.HTML(item.Content, baseURL)),
+		}
+		if item.PublishedParsed != nil {
+			publishedAt := item.PublishedParsed.Format(time.RFC3339)
+			scraped.PublishedAt = &publishedAt
+		}
+		items = append(items, scraped)
+	}
+	return &model.ScrapedFeedPreview{
+		Title: f.Title,
+		Items: items,
+	}, nil
+}
+
+// RefreshJob is the resolver for the refreshJob field.
+func (r *queryResolver) RefreshJob(ctx context.Context, id string) (*model.RefreshJob, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh job ID: %w", err)
+	}
+
+	job, ok := r.FetchQueue.Get(userID, jobID)
+	if !ok {
+		return nil, nil
+	}
+	return refreshJobToModel(job), nil
+}
+
+// ArticleAdded is the resolver for the articleAdded field.
+func (r *subscriptionResolver) ArticleAdded(ctx context.Context, feedID *string, folderID *string) (<-chan *model.Article, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filterFeedID int64
+	if feedID != nil {
+		// Check authorization
+		f, err := r.Query().Feed(ctx, *feedID)
+		if err != nil {
+			return nil, err
+		}
+		filterFeedID, _ = strconv.ParseInt(f.ID, 10, 64)
+	}
+	var filterFolderID string
+	if folderID != nil {
+		// Check authorization
+		f, err := r.Query().Folder(ctx, *folderID)
+		if err != nil {
+			return nil, err
+		}
+		filterFolderID = f.ID
+	}
+
+	events := r.PubSub.Subscribe(ctx, userID)
+	ch := make(chan *model.Article)
+	go func() {
+		defer close(ch)
+		for event := range events {
+			if event.Type != pubsub.ArticleAdded || (filterFeedID != 0 && event.FeedID != filterFeedID) {
+				continue
+			}
+			article, err := r.Query().Article(ctx, strconv.FormatInt(event.ArticleID, 10))
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to load added article", "article_id", event.ArticleID, "error", err)
+				continue
+			}
+			// The folder is checked when the article is added, so feeds moved in later are included
+			if filterFolderID != "" && (article.Feed.FolderID == nil || *article.Feed.FolderID != filterFolderID) {
+				continue
+			}
+			select {
+			case ch <- article:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// FeedUpdated is the resolver for the feedUpdated field.
+func (r *subscriptionResolver) FeedUpdated(ctx context.Context, feedID *string) (<-chan *model.Feed, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filterFeedID int64
+	if feedID != nil {
+		// Check authorization
+		f, err := r.Query().Feed(ctx, *feedID)
+		if err != nil {
+			return nil, err
+		}
+		filterFeedID, _ = strconv.ParseInt(f.ID, 10, 64)
+	}
+
+	events := r.PubSub.Subscribe(ctx, userID)
+	ch := make(chan *model.Feed)
+	go func() {
+		defer close(ch)
+		for event := range events {
+			if event.Type != pubsub.FeedUpdated || (filterFeedID != 0 && event.FeedID != filterFeedID) {
+				continue
+			}
+			f, err := r.Query().Feed(ctx, strconv.FormatInt(event.FeedID, 10))
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to load updated feed", "feed_id", event.FeedID, "error", err)
+				continue
+			}
+			select {
+			case ch <- f:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// UnreadCountChanged is the resolver for the unreadCountChanged field.
+func (r *subscriptionResolver) UnreadCountChanged(ctx context.Context) (<-chan int32, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := r.PubSub.Subscribe(ctx, userID)
+	ch := make(chan int32)
+	go func() {
+		defer close(ch)
+		for event := range events {
+			if event.Type != pubsub.UnreadCountChanged {
+				continue
+			}
+			count, err := r.Queries.CountUnreadArticles(ctx, userID)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to count unread articles", "error", err)
+				continue
+			}
+			select {
+			case ch <- int32(count):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// RefreshJobUpdated is the resolver for the refreshJobUpdated field.
+func (r *subscriptionResolver) RefreshJobUpdated(ctx context.Context, id string) (<-chan *model.RefreshJob, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh job ID: %w", err)
+	}
+	if _, ok := r.FetchQueue.Get(userID, jobID); !ok {
+		return nil, fmt.Errorf("refresh job not found")
+	}
+
+	// The subscription ends when the job finishes, so stop receiving events then
+	ctx, cancel := context.WithCancel(ctx)
+	events := r.PubSub.Subscribe(ctx, userID)
+	ch := make(chan *model.RefreshJob)
+	go func() {
+		defer close(ch)
+		defer cancel()
+		// Send the current status first, since the job may have changed before subscribing
+		send := func() bool {
+			job, ok := r.FetchQueue.Get(userID, jobID)
+			if !ok {
+				return false
+			}
+			select {
+			case ch <- refreshJobToModel(job):
+			case <-ctx.Done():
+				return false
+			}
+			// Finished jobs are not updated any more
+			return job.FinishedAt.IsZero()
+		}
+		if !send() {
+			return
+		}
+		for event := range events {
+			if event.Type != pubsub.RefreshJobUpdated || event.JobID != jobID {
+				continue
+			}
+			if !send() {
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Article returns gql.ArticleResolver implementation.
+func (r *Resolver) Article() gql.ArticleResolver { return &articleResolver{r} }
+
+// Feed returns gql.FeedResolver implementation.
+func (r *Resolver) Feed() gql.FeedResolver { return &feedResolver{r} }
+
+// Folder returns gql.FolderResolver implementation.
+func (r *Resolver) Folder() gql.FolderResolver { return &folderResolver{r} }
+
 // Mutation returns gql.MutationResolver implementation.
 func (r *Resolver) Mutation() gql.MutationResolver { return &mutationResolver{r} }
 
 // Query returns gql.QueryResolver implementation.
 func (r *Resolver) Query() gql.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns gql.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() gql.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type articleResolver struct{ *Resolver }
+type feedResolver struct{ *Resolver }
+type folderResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,514 @@
+package greader
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"undef.ninja/x/feedaka/auth"
+	appcontext "undef.ninja/x/feedaka/context"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/websub"
+)
+
+// Google Reader API as implemented by FreshRSS and Miniflux:
+// https://github.com/FreshRSS/FreshRSS/blob/edge/p/api/greader.php
+
+const (
+	streamReadingList = "user/-/state/com.google/reading-list"
+	streamRead        = "user/-/state/com.google/read"
+	streamStarred     = "user/-/state/com.google/starred"
+	streamKeptUnread  = "user/-/state/com.google/kept-unread"
+	feedStreamPrefix  = "feed/"
+	labelStreamPrefix = "user/-/label/"
+)
+
+type Handler struct {
+	queries    db.Store
+	bus        *pubsub.Bus
+	subscriber *websub.Subscriber
+	fetcher    *feed.Fetcher
+	secret     []byte
+}
+
+// NewHandler creates a Google Reader API handler. Auth tokens are signed with secret.
+func NewHandler(queries db.Store, bus *pubsub.Bus, subscriber *websub.Subscriber, fetcher *feed.Fetcher, secret string) *Handler {
+	return &Handler{
+		queries:    queries,
+		bus:        bus,
+		subscriber: subscriber,
+		fetcher:    fetcher,
+		secret:     []byte(secret),
+	}
+}
+
+// Register adds the API routes to e.
+func (h *Handler) Register(e *echo.Echo) {
+	getPost := []string{http.MethodGet, http.MethodPost}
+
+	e.Match(getPost, "/accounts/ClientLogin", h.clientLogin)
+
+	g := e.Group("/reader/api/0", h.authMiddleware)
+	g.GET("/token", h.token)
+	g.GET("/user-info", h.userInfo)
+	g.GET("/subscription/list", h.subscriptionList)
+	g.POST("/subscription/edit", h.subscriptionEdit)
+	g.POST("/subscription/quickadd", h.subscriptionQuickAdd)
+	g.GET("/tag/list", h.tagList)
+	g.GET("/unread-count", h.unreadCount)
+	g.GET("/stream/items/ids", h.streamItemIDs)
+	g.Match(getPost, "/stream/items/contents", h.streamItemContents)
+	g.GET("/stream/contents", h.streamContents)
+	g.GET("/stream/contents/*", h.streamContents)
+	g.POST("/edit-tag", h.editTag)
+	g.POST("/mark-all-as-read", h.markAllAsRead)
+}
+
+// sign returns an HMAC of the user's password hash. Changing the password invalidates every token.
+func (h *Handler) sign(purpose string, userID int64, passwordHash string) string {
+	mac := hmac.New(sha256.New, h.secret)
+	fmt.Fprintf(mac, "greader:%s:%d:%s", purpose, userID, passwordHash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// authToken returns a stateless auth token of the form "<user ID>/<signature>".
+func (h *Handler) authToken(userID int64, passwordHash string) string {
+	return fmt.Sprintf("%d/%s", userID, h.sign("auth", userID, passwordHash))
+}
+
+func (h *Handler) clientLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	username := c.FormValue("Email")
+	password := c.FormValue("Passwd")
+
+	user, err := h.queries.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.String(http.StatusUnauthorized, "Error=BadAuthentication\n")
+		}
+		return err
+	}
+	if !auth.VerifyPassword(user.PasswordHash, password) || user.Disabled != 0 {
+		return c.String(http.StatusUnauthorized, "Error=BadAuthentication\n")
+	}
+
+	token := h.authToken(user.ID, user.PasswordHash)
+	if c.FormValue("output") == "json" {
+		return c.JSON(http.StatusOK, map[string]string{
+			"SID":  token,
+			"LSID": "null",
+			"Auth": token,
+		})
+	}
+	return c.String(http.StatusOK, fmt.Sprintf("SID=%s\nLSID=null\nAuth=%s\n", token, token))
+}
+
+// authMiddleware authenticates requests by the "Authorization: GoogleLogin auth=<token>" header.
+func (h *Handler) authMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, ok := strings.CutPrefix(c.Request().Header.Get("Authorization"), "GoogleLogin auth=")
+		if !ok {
+			return echo.ErrUnauthorized
+		}
+		idStr, signature, ok := strings.Cut(token, "/")
+		if !ok {
+			return echo.ErrUnauthorized
+		}
+		userID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return echo.ErrUnauthorized
+		}
+
+		user, err := h.queries.GetUserByID(c.Request().Context(), userID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return echo.ErrUnauthorized
+			}
+			return err
+		}
+		if !hmac.Equal([]byte(signature), []byte(h.sign("auth", user.ID, user.PasswordHash))) || user.Disabled != 0 {
+			return echo.ErrUnauthorized
+		}
+
+		ctx := appcontext.SetUserID(c.Request().Context(), user.ID)
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
+
+func userID(c echo.Context) int64 {
+	// Set by authMiddleware
+	id, _ := appcontext.GetUserID(c.Request().Context())
+	return id
+}
+
+// token returns the token that clients pass as "T" to modifying requests. The auth token is sent
+// in a header, which browsers do not add to cross-site requests, so it is not checked.
+func (h *Handler) token(c echo.Context) error {
+	user, err := h.queries.GetUserByID(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	return c.String(http.StatusOK, h.sign("token", user.ID, user.PasswordHash))
+}
+
+func (h *Handler) userInfo(c echo.Context) error {
+	user, err := h.queries.GetUserByID(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	id := strconv.FormatInt(user.ID, 10)
+	return c.JSON(http.StatusOK, map[string]string{
+		"userId":        id,
+		"userName":      user.Username,
+		"userProfileId": id,
+		"userEmail":     "",
+	})
+}
+
+type subscription struct {
+	ID         string     `json:"id"`
+	Title      string     `json:"title"`
+	Categories []category `json:"categories"`
+	URL        string     `json:"url"`
+	HTMLURL    string     `json:"htmlUrl"`
+	IconURL    string     `json:"iconUrl"`
+}
+
+type category struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+func feedStreamID(feedID int64) string {
+	return feedStreamPrefix + strconv.FormatInt(feedID, 10)
+}
+
+// Folders are exposed as labels named after them.
+func labelStreamID(folderName string) string {
+	return labelStreamPrefix + folderName
+}
+
+// folderNames returns the names of the user's folders by ID.
+func (h *Handler) folderNames(c echo.Context) (map[int64]string, error) {
+	folders, err := h.queries.GetFolders(c.Request().Context(), userID(c))
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(folders))
+	for _, f := range folders {
+		names[f.ID] = f.Name
+	}
+	return names, nil
+}
+
+func (h *Handler) subscriptionList(c echo.Context) error {
+	feeds, err := h.queries.GetFeeds(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	folderNames, err := h.folderNames(c)
+	if err != nil {
+		return err
+	}
+
+	subscriptions := make([]subscription, 0, len(feeds))
+	for _, f := range feeds {
+		categories := []category{}
+		if f.FolderID.Valid {
+			name := folderNames[f.FolderID.Int64]
+			categories = append(categories, category{ID: labelStreamID(name), Label: name})
+		}
+		subscriptions = append(subscriptions, subscription{
+			ID:         feedStreamID(f.ID),
+			Title:      f.Title,
+			Categories: categories,
+			URL:        f.Url,
+			HTMLURL:    f.Url,
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]any{
+		"subscriptions": subscriptions,
+	})
+}
+
+func (h *Handler) subscriptionEdit(c echo.Context) error {
+	ctx := c.Request().Context()
+	uid := userID(c)
+	streamID := c.FormValue("s")
+
+	switch c.FormValue("ac") {
+	case "subscribe":
+		url, ok := strings.CutPrefix(streamID, feedStreamPrefix)
+		if !ok || url == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid stream: "+streamID)
+		}
+		f, err := h.subscribe(c, url)
+		if err != nil {
+			if errors.Is(err, feed.ErrAlreadySubscribed) {
+				break
+			}
+			return err
+		}
+		if err := h.editFolder(c, f); err != nil {
+			return err
+		}
+	case "unsubscribe":
+		f, err := h.getFeed(c, streamID)
+		if err != nil {
+			return err
+		}
+		err = h.queries.UnsubscribeFeed(ctx, f.ID)
+		if err != nil {
+			return fmt.Errorf("failed to unsubscribe from feed: %w", err)
+		}
+		if err := h.subscriber.Unsubscribe(ctx, f.ID); err != nil {
+			slog.WarnContext(ctx, "Failed to unsubscribe from WebSub hub", "feed_id", f.ID, "feed_url", logging.URL(f.Url), "error", err)
+		}
+		h.bus.Publish(uid, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+		h.bus.Publish(uid, pubsub.Event{Type: pubsub.UnreadCountChanged})
+	case "edit":
+		// Feed titles come from the feeds themselves, so renaming is accepted but ignored
+		f, err := h.getFeed(c, streamID)
+		if err != nil {
+			return err
+		}
+		if err := h.editFolder(c, f); err != nil {
+			return err
+		}
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown action: "+c.FormValue("ac"))
+	}
+	return c.String(http.StatusOK, "OK")
+}
+
+// editFolder moves the feed to the folder named by the label added with "a", creating the folder if needed, or out
+// of its folder if its label is removed with "r". A feed is in at most one folder, so the last added label wins.
+func (h *Handler) editFolder(c echo.Context, f db.Feed) error {
+	ctx := c.Request().Context()
+	uid := userID(c)
+	form, err := c.FormParams()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var added string
+	for _, label := range form["a"] {
+		if name, ok := strings.CutPrefix(normalizeStreamID(label), labelStreamPrefix); ok {
+			added = strings.TrimSpace(name)
+			if added == "" {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid label: "+label)
+			}
+		}
+	}
+	removed := map[string]bool{}
+	for _, label := range form["r"] {
+		if name, ok := strings.CutPrefix(normalizeStreamID(label), labelStreamPrefix); ok {
+			removed[strings.TrimSpace(name)] = true
+		}
+	}
+	if added == "" && len(removed) == 0 {
+		return nil
+	}
+
+	err = h.queries.InTx(ctx, func(qtx db.Store) error {
+		var folderID sql.NullInt64
+		if added != "" {
+			folder, err := qtx.GetFolderByName(ctx, db.GetFolderByNameParams{UserID: uid, Name: added})
+			if err == sql.ErrNoRows {
+				folder, err = qtx.CreateFolder(ctx, db.CreateFolderParams{
+					UserID:    uid,
+					Name:      added,
+					CreatedAt: time.Now().UTC().Format(time.RFC3339),
+				})
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get folder: %w", err)
+			}
+			folderID = sql.NullInt64{Int64: folder.ID, Valid: true}
+		} else {
+			// Only removing the label of the feed's current folder takes it out of the folder
+			if !f.FolderID.Valid {
+				return nil
+			}
+			folder, err := qtx.GetFolder(ctx, f.FolderID.Int64)
+			if err != nil {
+				return fmt.Errorf("failed to query folder: %w", err)
+			}
+			if !removed[folder.Name] {
+				return nil
+			}
+		}
+		return qtx.UpdateFeedFolder(ctx, db.UpdateFeedFolderParams{
+			FolderID: folderID,
+			ID:       f.ID,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update feed folder: %w", err)
+	}
+	h.bus.Publish(uid, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+	return nil
+}
+
+func (h *Handler) subscriptionQuickAdd(c echo.Context) error {
+	url := strings.TrimPrefix(c.FormValue("quickadd"), feedStreamPrefix)
+	if url == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing quickadd")
+	}
+	f, err := h.subscribe(c, url)
+	if err != nil {
+		if errors.Is(err, feed.ErrAlreadySubscribed) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return err
+	}
+	return c.JSON(http.StatusOK, map[string]any{
+		"numResults": 1,
+		"query":      url,
+		"streamId":   feedStreamID(f.ID),
+		"streamName": f.Title,
+	})
+}
+
+func (h *Handler) subscribe(c echo.Context, url string) (db.Feed, error) {
+	ctx := c.Request().Context()
+	uid := userID(c)
+
+	dbFeed, f, added, err := h.fetcher.Add(ctx, h.queries, uid, url, feed.AddOptions{})
+	if err != nil {
+		return db.Feed{}, err
+	}
+	h.bus.PublishFeedSynced(uid, dbFeed.ID, added)
+
+	if err := h.subscriber.Subscribe(ctx, dbFeed.ID, dbFeed.Url, f); err != nil {
+		slog.WarnContext(ctx, "Failed to subscribe to WebSub hub", "feed_id", dbFeed.ID, "feed_url", logging.URL(dbFeed.Url), "error", err)
+	}
+	return dbFeed, nil
+}
+
+// getFeed returns the feed identified by a "feed/<id>" stream ID if it belongs to the user.
+func (h *Handler) getFeed(c echo.Context, streamID string) (db.Feed, error) {
+	feedID, err := strconv.ParseInt(strings.TrimPrefix(streamID, feedStreamPrefix), 10, 64)
+	if err != nil {
+		return db.Feed{}, echo.NewHTTPError(http.StatusBadRequest, "invalid stream: "+streamID)
+	}
+	f, err := h.queries.GetFeed(c.Request().Context(), feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Feed{}, echo.NewHTTPError(http.StatusNotFound, "feed not found")
+		}
+		return db.Feed{}, fmt.Errorf("failed to query feed: %w", err)
+	}
+	if f.UserID != userID(c) {
+		return db.Feed{}, echo.NewHTTPError(http.StatusNotFound, "feed not found")
+	}
+	return f, nil
+}
+
+func (h *Handler) tagList(c echo.Context) error {
+	folders, err := h.queries.GetFolders(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	tags := []map[string]string{
+		{"id": streamStarred},
+	}
+	for _, f := range folders {
+		tags = append(tags, map[string]string{"id": labelStreamID(f.Name), "type": "folder"})
+	}
+	return c.JSON(http.StatusOK, map[string]any{
+		"tags": tags,
+	})
+}
+
+type unreadCount struct {
+	ID                      string `json:"id"`
+	Count                   int64  `json:"count"`
+	NewestItemTimestampUsec string `json:"newestItemTimestampUsec"`
+}
+
+func (h *Handler) unreadCount(c echo.Context) error {
+	rows, err := h.queries.GetUnreadCountsByFeed(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	feeds, err := h.queries.GetFeeds(c.Request().Context(), userID(c))
+	if err != nil {
+		return err
+	}
+	folderNames, err := h.folderNames(c)
+	if err != nil {
+		return err
+	}
+	feedFolders := make(map[int64]sql.NullInt64, len(feeds))
+	for _, f := range feeds {
+		feedFolders[f.ID] = f.FolderID
+	}
+
+	counts := make([]unreadCount, 0, len(rows)+len(folderNames)+1)
+	var total int64
+	newest := time.Unix(0, 0)
+	// Labels count the unread articles of the feeds in their folders
+	var folderIDs []int64
+	folderCounts := map[int64]int64{}
+	folderNewest := map[int64]time.Time{}
+	for _, row := range rows {
+		t := parseTime(row.NewestPublishedAt)
+		counts = append(counts, unreadCount{
+			ID:                      feedStreamID(row.FeedID),
+			Count:                   row.UnreadCount,
+			NewestItemTimestampUsec: strconv.FormatInt(t.UnixMicro(), 10),
+		})
+		total += row.UnreadCount
+		if t.After(newest) {
+			newest = t
+		}
+		if folderID := feedFolders[row.FeedID]; folderID.Valid {
+			if _, ok := folderCounts[folderID.Int64]; !ok {
+				folderIDs = append(folderIDs, folderID.Int64)
+			}
+			folderCounts[folderID.Int64] += row.UnreadCount
+			if t.After(folderNewest[folderID.Int64]) {
+				folderNewest[folderID.Int64] = t
+			}
+		}
+	}
+	for _, folderID := range folderIDs {
+		counts = append(counts, unreadCount{
+			ID:                      labelStreamID(folderNames[folderID]),
+			Count:                   folderCounts[folderID],
+			NewestItemTimestampUsec: strconv.FormatInt(folderNewest[folderID].UnixMicro(), 10),
+		})
+	}
+	counts = append(counts, unreadCount{
+		ID:                      streamReadingList,
+		Count:                   total,
+		NewestItemTimestampUsec: strconv.FormatInt(newest.UnixMicro(), 10),
+	})
+	return c.JSON(http.StatusOK, map[string]any{
+		"max":          total,
+		"unreadcounts": counts,
+	})
+}
+
+func parseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Unix(0, 0)
+	}
+	return t
+}
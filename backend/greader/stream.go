@@ -0,0 +1,570 @@
+package greader
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/pubsub"
+	"undef.ninja/x/feedaka/sanitize"
+)
+
+const itemIDPrefix = "tag:google.com,2005:reader/item/"
+
+const (
+	defaultCount = 20
+	// Maximum number of items returned by stream/contents and stream/items/contents
+	maxContentsCount = 1000
+	// Maximum number of IDs returned by stream/items/ids
+	maxIDsCount = 10000
+)
+
+// Clients may refer to the current user by ID instead of "-", e.g. "user/1/state/com.google/read".
+var userIDPattern = regexp.MustCompile(`^user/\d+/`)
+
+func normalizeStreamID(id string) string {
+	return userIDPattern.ReplaceAllString(id, "user/-/")
+}
+
+// stream is a filter on the user's articles.
+type stream struct {
+	feedID int64
+	// Name of the folder of a label stream
+	folderName  string
+	isRead      int64 // -1 matches both read and unread articles
+	starredOnly bool
+	// empty is set for streams that never contain articles, e.g. read articles that are unread
+	empty bool
+}
+
+func parseStream(id string) (stream, error) {
+	s := stream{isRead: -1}
+	switch id = normalizeStreamID(id); {
+	case id == streamReadingList:
+	case id == streamRead:
+		s.isRead = 1
+	case id == streamStarred:
+		s.starredOnly = true
+	case strings.HasPrefix(id, labelStreamPrefix):
+		s.folderName = strings.TrimPrefix(id, labelStreamPrefix)
+		s.empty = s.folderName == ""
+	case strings.HasPrefix(id, feedStreamPrefix):
+		feedID, err := strconv.ParseInt(strings.TrimPrefix(id, feedStreamPrefix), 10, 64)
+		if err != nil {
+			return s, fmt.Errorf("invalid stream: %s", id)
+		}
+		s.feedID = feedID
+	default:
+		return s, fmt.Errorf("unknown stream: %s", id)
+	}
+	return s, nil
+}
+
+// include narrows the stream by the "it" (include target) parameter.
+func (s *stream) include(id string) {
+	switch normalizeStreamID(id) {
+	case streamRead:
+		s.empty = s.empty || s.isRead == 0
+		s.isRead = 1
+	case streamStarred:
+		s.starredOnly = true
+	}
+}
+
+// exclude narrows the stream by the "xt" (exclude target) parameter.
+func (s *stream) exclude(id string) {
+	switch normalizeStreamID(id) {
+	case streamRead:
+		s.empty = s.empty || s.isRead == 1
+		s.isRead = 0
+	case streamStarred:
+		s.empty = s.empty || s.starredOnly
+	}
+}
+
+func parseItemID(s string) (int64, error) {
+	// The long form is hexadecimal and the short form is decimal
+	if hexID, ok := strings.CutPrefix(s, itemIDPrefix); ok {
+		id, err := strconv.ParseUint(hexID, 16, 64)
+		return int64(id), err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func longItemID(id int64) string {
+	return fmt.Sprintf("%s%016x", itemIDPrefix, uint64(id))
+}
+
+// queryStream returns a page of articles in the stream and the continuation token of the next page.
+// The request parameters are:
+//
+//	n:  number of items
+//	r:  "o" to return the oldest items first
+//	c:  continuation token from the previous page
+//	ot: exclude items published before this Unix time
+//	nt: exclude items published after this Unix time
+//	it: include only items with this tag
+//	xt: exclude items with this tag
+func queryStream(c echo.Context, queries db.Store, streamID string, maxCount int) ([]db.Article, string, error) {
+	s, err := parseStream(streamID)
+	if err != nil {
+		return nil, "", echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	params := c.QueryParams()
+	for _, id := range params["it"] {
+		s.include(id)
+	}
+	for _, id := range params["xt"] {
+		s.exclude(id)
+	}
+	if s.empty {
+		return nil, "", nil
+	}
+	var folderID int64
+	if s.folderName != "" {
+		folder, err := queries.GetFolderByName(c.Request().Context(), db.GetFolderByNameParams{
+			UserID: userID(c),
+			Name:   s.folderName,
+		})
+		if err == sql.ErrNoRows {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		folderID = folder.ID
+	}
+
+	count := defaultCount
+	if n, err := strconv.Atoi(c.QueryParam("n")); err == nil && n > 0 {
+		count = min(n, maxCount)
+	}
+	oldestFirst := c.QueryParam("r") == "o"
+
+	arg := db.GetStreamArticlesParams{
+		UserID:         userID(c),
+		FeedID:         s.feedID,
+		FolderID:       folderID,
+		IsRead:         s.isRead,
+		MinID:          0,
+		MaxID:          math.MaxInt64,
+		PublishedSince: "",
+		// Later than any RFC3339 time
+		PublishedBefore: "9",
+		// Fetch one more item to know whether there is a next page
+		Limit: int64(count) + 1,
+	}
+	if s.starredOnly {
+		arg.StarredOnly = 1
+	}
+	if continuation, err := strconv.ParseInt(c.QueryParam("c"), 10, 64); err == nil {
+		if oldestFirst {
+			arg.MinID = continuation
+		} else {
+			arg.MaxID = continuation
+		}
+	}
+	if ot, err := strconv.ParseInt(c.QueryParam("ot"), 10, 64); err == nil {
+		arg.PublishedSince = time.Unix(ot, 0).UTC().Format(time.RFC3339)
+	}
+	if nt, err := strconv.ParseInt(c.QueryParam("nt"), 10, 64); err == nil {
+		arg.PublishedBefore = time.Unix(nt+1, 0).UTC().Format(time.RFC3339)
+	}
+
+	var articles []db.Article
+	if oldestFirst {
+		articles, err = queries.GetStreamArticlesOldestFirst(c.Request().Context(), db.GetStreamArticlesOldestFirstParams(arg))
+	} else {
+		articles, err = queries.GetStreamArticles(c.Request().Context(), arg)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var continuation string
+	if len(articles) > count {
+		articles = articles[:count]
+		continuation = strconv.FormatInt(articles[count-1].ID, 10)
+	}
+	return articles, continuation, nil
+}
+
+type itemRef struct {
+	ID              string   `json:"id"`
+	DirectStreamIDs []string `json:"directStreamIds"`
+	TimestampUsec   string   `json:"timestampUsec"`
+}
+
+func (h *Handler) streamItemIDs(c echo.Context) error {
+	articles, continuation, err := queryStream(c, h.queries, c.QueryParam("s"), maxIDsCount)
+	if err != nil {
+		return err
+	}
+
+	refs := make([]itemRef, 0, len(articles))
+	for _, a := range articles {
+		refs = append(refs, itemRef{
+			ID:              strconv.FormatInt(a.ID, 10),
+			DirectStreamIDs: []string{},
+			TimestampUsec:   strconv.FormatInt(parseTime(a.PublishedAt).UnixMicro(), 10),
+		})
+	}
+	resp := map[string]any{
+		"itemRefs": refs,
+	}
+	if continuation != "" {
+		resp["continuation"] = continuation
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+type link struct {
+	Href string `json:"href"`
+	Type string `json:"type,omitempty"`
+}
+
+type origin struct {
+	StreamID string `json:"streamId"`
+	Title    string `json:"title"`
+	HTMLURL  string `json:"htmlUrl"`
+}
+
+type content struct {
+	Direction string `json:"direction"`
+	Content   string `json:"content"`
+}
+
+type item struct {
+	ID            string   `json:"id"`
+	CrawlTimeMsec string   `json:"crawlTimeMsec"`
+	TimestampUsec string   `json:"timestampUsec"`
+	Published     int64    `json:"published"`
+	Updated       int64    `json:"updated"`
+	Title         string   `json:"title"`
+	Canonical     []link   `json:"canonical"`
+	Alternate     []link   `json:"alternate"`
+	Categories    []string `json:"categories"`
+	Origin        origin   `json:"origin"`
+	Summary       content  `json:"summary"`
+	Author        string   `json:"author"`
+}
+
+func (h *Handler) items(c echo.Context, articles []db.Article) ([]item, error) {
+	feeds, err := h.queries.GetFeeds(c.Request().Context(), userID(c))
+	if err != nil {
+		return nil, err
+	}
+	feedsByID := make(map[int64]db.Feed, len(feeds))
+	for _, f := range feeds {
+		feedsByID[f.ID] = f
+	}
+	folderNames, err := h.folderNames(c)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]item, 0, len(articles))
+	for _, a := range articles {
+		t := parseTime(a.PublishedAt)
+		f := feedsByID[a.FeedID]
+		categories := []string{streamReadingList}
+		if a.IsRead == 1 {
+			categories = append(categories, streamRead)
+		}
+		if a.IsStarred == 1 {
+			categories = append(categories, streamStarred)
+		}
+		if f.FolderID.Valid {
+			categories = append(categories, labelStreamID(folderNames[f.FolderID.Int64]))
+		}
+		items = append(items, item{
+			ID:            longItemID(a.ID),
+			CrawlTimeMsec: strconv.FormatInt(t.UnixMilli(), 10),
+			TimestampUsec: strconv.FormatInt(t.UnixMicro(), 10),
+			Published:     t.Unix(),
+			Updated:       t.Unix(),
+			Title:         a.Title,
+			Canonical:     []link{{Href: a.Url}},
+			Alternate:     []link{{Href: a.Url, Type: "text/html"}},
+			Categories:    categories,
+			Origin: origin{
+				StreamID: feedStreamID(a.FeedID),
+				Title:    f.Title,
+				HTMLURL:  f.Url,
+			},
+			Summary: content{Direction: "ltr", Content: sanitize.HTML(a.Content, a.Url)},
+		})
+	}
+	return items, nil
+}
+
+func (h *Handler) streamContents(c echo.Context) error {
+	streamID := c.Param("*")
+	if unescaped, err := url.PathUnescape(streamID); err == nil {
+		streamID = unescaped
+	}
+	if streamID == "" {
+		streamID = c.QueryParam("s")
+	}
+	if streamID == "" {
+		streamID = streamReadingList
+	}
+
+	articles, continuation, err := queryStream(c, h.queries, streamID, maxContentsCount)
+	if err != nil {
+		return err
+	}
+	items, err := h.items(c, articles)
+	if err != nil {
+		return err
+	}
+
+	resp := map[string]any{
+		"direction": "ltr",
+		"id":        streamID,
+		"title":     "",
+		"updated":   time.Now().Unix(),
+		"items":     items,
+	}
+	if continuation != "" {
+		resp["continuation"] = continuation
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// itemIDs returns the article IDs given as the repeated "i" parameter.
+func itemIDs(c echo.Context) ([]int64, error) {
+	form, err := c.FormParams()
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	ids := make([]int64, 0, len(form["i"]))
+	for _, s := range form["i"] {
+		id, err := parseItemID(s)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid item ID: "+s)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (h *Handler) streamItemContents(c echo.Context) error {
+	ids, err := itemIDs(c)
+	if err != nil {
+		return err
+	}
+	if len(ids) > maxContentsCount {
+		ids = ids[:maxContentsCount]
+	}
+
+	articles, err := h.queries.GetArticlesByIDs(c.Request().Context(), db.GetArticlesByIDsParams{
+		UserID: userID(c),
+		Ids:    ids,
+	})
+	if err != nil {
+		return err
+	}
+	items, err := h.items(c, articles)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"direction": "ltr",
+		"id":        streamReadingList,
+		"title":     "",
+		"updated":   time.Now().Unix(),
+		"items":     items,
+	})
+}
+
+func (h *Handler) editTag(c echo.Context) error {
+	ctx := c.Request().Context()
+	uid := userID(c)
+
+	ids, err := itemIDs(c)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	var isRead, isStarred *int64
+	set := func(tag string, added bool) {
+		v := int64(0)
+		if added {
+			v = 1
+		}
+		switch normalizeStreamID(tag) {
+		case streamRead:
+			isRead = &v
+		case streamKeptUnread:
+			if added {
+				isRead = new(int64)
+			}
+		case streamStarred:
+			isStarred = &v
+		}
+	}
+	form, _ := c.FormParams()
+	for _, tag := range form["a"] {
+		set(tag, true)
+	}
+	for _, tag := range form["r"] {
+		set(tag, false)
+	}
+
+	// Only the user's own articles are returned
+	articles, err := h.queries.GetArticlesByIDs(ctx, db.GetArticlesByIDsParams{
+		UserID: uid,
+		Ids:    ids,
+	})
+	if err != nil {
+		return err
+	}
+
+	updatedFeeds := map[int64]bool{}
+	for _, a := range articles {
+		if isRead != nil && a.IsRead != *isRead {
+			err := h.queries.UpdateArticleReadStatus(ctx, db.UpdateArticleReadStatusParams{
+				IsRead: *isRead,
+				ID:     a.ID,
+			})
+			if err != nil {
+				return err
+			}
+			updatedFeeds[a.FeedID] = true
+		}
+		if isStarred != nil && a.IsStarred != *isStarred {
+			err := h.queries.UpdateArticleStarredStatus(ctx, db.UpdateArticleStarredStatusParams{
+				IsStarred: *isStarred,
+				ID:        a.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for feedID := range updatedFeeds {
+		h.bus.Publish(uid, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feedID})
+	}
+	if len(updatedFeeds) > 0 {
+		h.bus.Publish(uid, pubsub.Event{Type: pubsub.UnreadCountChanged})
+	}
+	return c.String(http.StatusOK, "OK")
+}
+
+func (h *Handler) markAllAsRead(c echo.Context) error {
+	ctx := c.Request().Context()
+	uid := userID(c)
+
+	before := time.Now()
+	// "ts" is in microseconds
+	if ts, err := strconv.ParseInt(c.FormValue("ts"), 10, 64); err == nil {
+		before = time.UnixMicro(ts)
+	}
+	beforeStr := before.UTC().Format(time.RFC3339)
+
+	streamID := normalizeStreamID(c.FormValue("s"))
+	switch {
+	case streamID == streamReadingList:
+		err := h.queries.MarkAllArticlesReadBefore(ctx, db.MarkAllArticlesReadBeforeParams{
+			Before: beforeStr,
+			UserID: uid,
+		})
+		if err != nil {
+			return err
+		}
+	case strings.HasPrefix(streamID, feedStreamPrefix):
+		f, err := h.getFeed(c, streamID)
+		if err != nil {
+			return err
+		}
+		err = h.queries.MarkFeedArticlesReadBefore(ctx, db.MarkFeedArticlesReadBeforeParams{
+			FeedID:      f.ID,
+			PublishedAt: beforeStr,
+		})
+		if err != nil {
+			return err
+		}
+		h.bus.Publish(uid, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: f.ID})
+	case strings.HasPrefix(streamID, labelStreamPrefix):
+		folder, err := h.queries.GetFolderByName(ctx, db.GetFolderByNameParams{
+			UserID: uid,
+			Name:   strings.TrimPrefix(streamID, labelStreamPrefix),
+		})
+		if err == sql.ErrNoRows {
+			return echo.NewHTTPError(http.StatusNotFound, "label not found")
+		}
+		if err != nil {
+			return err
+		}
+		feedIDs, err := h.markFolderRead(ctx, uid, folder.ID, before)
+		if err != nil {
+			return err
+		}
+		for _, feedID := range feedIDs {
+			h.bus.Publish(uid, pubsub.Event{Type: pubsub.FeedUpdated, FeedID: feedID})
+		}
+	default:
+		// Marking starred articles as read is not supported
+		return c.String(http.StatusOK, "OK")
+	}
+	h.bus.Publish(uid, pubsub.Event{Type: pubsub.UnreadCountChanged})
+	return c.String(http.StatusOK, "OK")
+}
+
+// markFolderRead marks the unread articles of the folder published at or before before as read, and returns the IDs
+// of the feeds that had such articles.
+func (h *Handler) markFolderRead(ctx context.Context, uid, folderID int64, before time.Time) ([]int64, error) {
+	var feedIDs []int64
+	err := h.queries.InTx(ctx, func(qtx db.Store) error {
+		arg := db.GetStreamArticlesParams{
+			UserID:   uid,
+			FolderID: folderID,
+			IsRead:   0,
+			MinID:    0,
+			MaxID:    math.MaxInt64,
+			// Published at or before the second of before
+			PublishedBefore: before.UTC().Truncate(time.Second).Add(time.Second).Format(time.RFC3339),
+			Limit:           maxContentsCount,
+		}
+		updated := map[int64]bool{}
+		for {
+			articles, err := qtx.GetStreamArticles(ctx, arg)
+			if err != nil {
+				return err
+			}
+			for _, a := range articles {
+				err := qtx.UpdateArticleReadStatus(ctx, db.UpdateArticleReadStatusParams{IsRead: 1, ID: a.ID})
+				if err != nil {
+					return err
+				}
+				if !updated[a.FeedID] {
+					updated[a.FeedID] = true
+					feedIDs = append(feedIDs, a.FeedID)
+				}
+			}
+			if len(articles) < maxContentsCount {
+				return nil
+			}
+			arg.MaxID = articles[len(articles)-1].ID
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark folder as read: %w", err)
+	}
+	return feedIDs, nil
+}
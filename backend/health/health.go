@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/fetchqueue"
+)
+
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+// States of the scheduler
+const (
+	// This process fetches feeds on schedule
+	schedulerActive = "active"
+	// Another process sharing the database fetches feeds on schedule
+	schedulerStandby = "standby"
+	// The server was started with -no-fetch
+	schedulerDisabled = "disabled"
+)
+
+// Timeout of the database ping
+const pingTimeout = 2 * time.Second
+
+type Component struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// Set for the scheduler only
+	State       string `json:"state,omitempty"`
+	LastCycleAt string `json:"last_cycle_at,omitempty"`
+}
+
+type Response struct {
+	Status     string               `json:"status"`
+	Components map[string]Component `json:"components,omitempty"`
+}
+
+type Handler struct {
+	database *db.DB
+	queue    *fetchqueue.Queue
+	// The scheduler is considered stuck if it has not queued feeds for this long
+	maxCycleAge time.Duration
+}
+
+// NewHandler creates a handler whose readiness check expects queue's scheduler to complete a cycle every maxCycleAge.
+func NewHandler(database *db.DB, queue *fetchqueue.Queue, maxCycleAge time.Duration) *Handler {
+	return &Handler{
+		database:    database,
+		queue:       queue,
+		maxCycleAge: maxCycleAge,
+	}
+}
+
+// Healthz reports that the process is up. It checks nothing else, so that a slow database does not get the
+// process restarted.
+func (h *Handler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, Response{Status: statusOK})
+}
+
+// Readyz reports whether the server can serve requests and fetch feeds. It responds with 503 if any component fails.
+func (h *Handler) Readyz(c echo.Context) error {
+	components := map[string]Component{
+		"database":  h.checkDatabase(c.Request().Context()),
+		"schema":    h.checkSchema(),
+		"scheduler": h.checkScheduler(time.Now()),
+	}
+
+	resp := Response{Status: statusOK, Components: components}
+	code := http.StatusOK
+	for _, component := range components {
+		if component.Status != statusOK {
+			resp.Status = statusFail
+			code = http.StatusServiceUnavailable
+		}
+	}
+	return c.JSON(code, resp)
+}
+
+func (h *Handler) checkDatabase(ctx context.Context) Component {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	if err := h.database.PingContext(ctx); err != nil {
+		return Component{Status: statusFail, Error: err.Error()}
+	}
+	return Component{Status: statusOK}
+}
+
+func (h *Handler) checkSchema() Component {
+	if err := db.ValidateSchemaVersion(h.database); err != nil {
+		return Component{Status: statusFail, Error: err.Error()}
+	}
+	return Component{Status: statusOK}
+}
+
+func (h *Handler) checkScheduler(now time.Time) Component {
+	status := h.queue.SchedulerStatus()
+	if !status.Running {
+		return Component{Status: statusFail, Error: "fetch workers are not running"}
+	}
+	if !status.Enabled {
+		return Component{Status: statusOK, State: schedulerDisabled}
+	}
+	if !status.Active {
+		// The process holding the lease reports whether it is stuck
+		return Component{Status: statusOK, State: schedulerStandby}
+	}
+
+	result := Component{Status: statusOK, State: schedulerActive}
+	// Before the first cycle since acquiring the lease, the scheduler is measured from when it acquired the lease
+	since := status.ActiveSince
+	if !status.LastCycleAt.IsZero() {
+		result.LastCycleAt = status.LastCycleAt.UTC().Format(time.RFC3339)
+		if status.LastCycleAt.After(since) {
+			since = status.LastCycleAt
+		}
+	}
+	if now.Sub(since) > h.maxCycleAge {
+		result.Status = statusFail
+		result.Error = fmt.Sprintf("no successful fetch cycle for %s", now.Sub(since).Round(time.Second))
+	}
+	return result
+}
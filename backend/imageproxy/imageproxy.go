@@ -0,0 +1,227 @@
+package imageproxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	appcontext "undef.ninja/x/feedaka/context"
+	"undef.ninja/x/feedaka/sanitize"
+)
+
+// Maximum size of a proxied image
+const maxImageSize = 10 << 20
+
+// Timeout of fetching an image
+const fetchTimeout = 15 * time.Second
+
+// Proxy serves remote images in article content from feedaka's own origin, so that reading an article does not
+// leak the reader's IP address or referrer to the image host, and http:// images do not cause mixed content.
+// A nil *Proxy leaves content unchanged.
+type Proxy struct {
+	secret   []byte
+	cacheDir string
+	// Cached images older than this are fetched again
+	cacheMaxAge time.Duration
+	client      *http.Client
+}
+
+// New creates a proxy that signs URLs with secret, fetches images with client and caches them under cacheDir
+// for cacheMaxAge. Image URLs come from feed content, so client should refuse to connect to non-public addresses.
+func New(secret, cacheDir string, cacheMaxAge time.Duration, client *http.Client) *Proxy {
+	return &Proxy{
+		secret:      []byte(secret),
+		cacheDir:    cacheDir,
+		cacheMaxAge: cacheMaxAge,
+		client:      client,
+	}
+}
+
+// sign returns the signature of rawURL. Only signed URLs are proxied, so the proxy cannot be used to fetch arbitrary URLs.
+func (p *Proxy) sign(rawURL string) string {
+	mac := hmac.New(sha256.New, p.secret)
+	fmt.Fprintf(mac, "imageproxy:%s", rawURL)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// URL returns the proxied URL of the image at rawURL. URLs other than http:// and https:// are returned as is.
+func (p *Proxy) URL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return rawURL
+	}
+	return "/proxy/image?" + url.Values{
+		"url": {rawURL},
+		"sig": {p.sign(rawURL)},
+	}.Encode()
+}
+
+// Rewrite replaces the URLs of images in sanitized HTML with proxied URLs.
+func (p *Proxy) Rewrite(content string) string {
+	if p == nil {
+		return content
+	}
+	return sanitize.RewriteImages(content, p.URL)
+}
+
+// Handle serves /proxy/image?url=...&sig=... to logged-in users.
+func (p *Proxy) Handle(c echo.Context) error {
+	if _, ok := appcontext.GetUserID(c.Request().Context()); !ok {
+		return echo.ErrUnauthorized
+	}
+	rawURL := c.QueryParam("url")
+	if !hmac.Equal([]byte(c.QueryParam("sig")), []byte(p.sign(rawURL))) {
+		return echo.ErrForbidden
+	}
+
+	key := cacheKey(rawURL)
+	contentType, body, err := p.readCache(key)
+	if err != nil {
+		contentType, body, err = p.fetch(c.Request().Context(), rawURL)
+		if err != nil {
+			slog.WarnContext(c.Request().Context(), "Failed to proxy image", "error", err)
+			return echo.ErrBadGateway
+		}
+		if err := p.writeCache(key, contentType, body); err != nil {
+			slog.ErrorContext(c.Request().Context(), "Failed to cache image", "error", err)
+		}
+	}
+
+	h := c.Response().Header()
+	h.Set("Cache-Control", "private, max-age=86400")
+	h.Set("X-Content-Type-Options", "nosniff")
+	// SVG images may contain scripts. They must not run when the proxied URL is opened directly.
+	h.Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+	return c.Blob(http.StatusOK, contentType, body)
+}
+
+func (p *Proxy) fetch(ctx context.Context, rawURL string) (string, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", "image/*")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("failed to fetch %s: %s", rawURL, resp.Status)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if !strings.HasPrefix(mediaType, "image/") {
+		return "", nil, fmt.Errorf("failed to fetch %s: not an image (%s)", rawURL, mediaType)
+	}
+	if resp.ContentLength > maxImageSize {
+		return "", nil, fmt.Errorf("failed to fetch %s: image too large (%d bytes)", rawURL, resp.ContentLength)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	if len(body) > maxImageSize {
+		return "", nil, fmt.Errorf("failed to fetch %s: image too large", rawURL)
+	}
+	return mediaType, body, nil
+}
+
+func cacheKey(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachePath returns the paths of the cached image and its content type.
+// Files are spread over subdirectories named by the first two characters of the key.
+func (p *Proxy) cachePath(key string) (string, string) {
+	path := filepath.Join(p.cacheDir, key[:2], key)
+	return path, path + ".type"
+}
+
+func (p *Proxy) readCache(key string) (string, []byte, error) {
+	path, typePath := p.cachePath(key)
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if time.Since(info.ModTime()) > p.cacheMaxAge {
+		return "", nil, fs.ErrNotExist
+	}
+	contentType, err := os.ReadFile(typePath)
+	if err != nil {
+		return "", nil, err
+	}
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(contentType), body, nil
+}
+
+func (p *Proxy) writeCache(key, contentType string, body []byte) error {
+	path, typePath := p.cachePath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// The type is written first, so that an image file is never read without its type
+	if err := writeFileAtomic(typePath, []byte(contentType)); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, body)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Prune removes cached images that are too old to be served.
+func (p *Proxy) Prune() error {
+	err := filepath.WalkDir(p.cacheDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if time.Since(info.ModTime()) > p.cacheMaxAge {
+			return os.Remove(path)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
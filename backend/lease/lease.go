@@ -0,0 +1,101 @@
+package lease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+// Lease is a lock stored in the database, so that only one of the processes sharing the database does a job.
+// It expires unless its holder renews it, so that another process takes over when the holder stops or crashes.
+type Lease struct {
+	queries db.Store
+	name    string
+	holder  string
+	ttl     time.Duration
+}
+
+// New creates a lease named name for this process. It is held for ttl after each successful Acquire.
+func New(queries db.Store, name string, ttl time.Duration) *Lease {
+	return &Lease{
+		queries: queries,
+		name:    name,
+		holder:  holderID(),
+		ttl:     ttl,
+	}
+}
+
+// holderID identifies this process in the leases table. The random suffix tells apart processes whose PIDs
+// collide, e.g. in containers.
+func holderID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	suffix := "0"
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err == nil {
+		suffix = hex.EncodeToString(b)
+	}
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), suffix)
+}
+
+// Holder returns the ID this process holds the lease as.
+func (l *Lease) Holder() string {
+	return l.holder
+}
+
+// TTL returns how long the lease is held after each successful Acquire.
+func (l *Lease) TTL() time.Duration {
+	return l.ttl
+}
+
+// Acquire takes the lease if it is free or expired, or extends it if this process already holds it. It returns
+// false if another process holds it.
+func (l *Lease) Acquire(ctx context.Context) (bool, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(l.ttl).Format(time.RFC3339)
+
+	// Taking over an expired lease and extending our own are the same update. Two processes taking over at once
+	// are serialized by the database, and the later one no longer matches.
+	n, err := l.queries.ExtendLease(ctx, db.ExtendLeaseParams{
+		Holder:    l.holder,
+		ExpiresAt: expiresAt,
+		Name:      l.name,
+		Now:       now.Format(time.RFC3339),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to extend lease: %w", err)
+	}
+	if n > 0 {
+		return true, nil
+	}
+
+	n, err = l.queries.CreateLease(ctx, db.CreateLeaseParams{
+		Name:      l.name,
+		Holder:    l.holder,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create lease: %w", err)
+	}
+	return n > 0, nil
+}
+
+// Release gives up the lease so that another process can take it over without waiting for it to expire. It does
+// nothing if this process does not hold the lease.
+func (l *Lease) Release(ctx context.Context) error {
+	err := l.queries.ReleaseLease(ctx, db.ReleaseLeaseParams{
+		Name:   l.name,
+		Holder: l.holder,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+)
+
+type contextKey struct{}
+
+// Setup makes slog's default logger, which the log package also writes to, output records in format ("text" or "json")
+// at level ("debug", "info", "warn" or "error") and above.
+func Setup(w io.Writer, format, level string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level: %q", level)
+	}
+	opts := &slog.HandlerOptions{Level: l}
+
+	var h slog.Handler
+	switch format {
+	case "text":
+		h = slog.NewTextHandler(w, opts)
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("invalid log format: %q", format)
+	}
+	slog.SetDefault(slog.New(&contextHandler{Handler: h}))
+	return nil
+}
+
+// With returns a context whose records carry args, given as alternating keys and values or slog.Attr like slog.Info.
+// Records are only annotated when they are logged with the context, e.g. by slog.InfoContext.
+func With(ctx context.Context, args ...any) context.Context {
+	var r slog.Record
+	r.Add(args...)
+	attrs := append([]slog.Attr{}, attrsFromContext(ctx)...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return context.WithValue(ctx, contextKey{}, attrs)
+}
+
+func attrsFromContext(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(contextKey{}).([]slog.Attr)
+	return attrs
+}
+
+// URL returns rawURL without the password it may contain, to be logged.
+func URL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Redacted()
+}
+
+// Fatal logs msg at the error level and exits.
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// contextHandler adds the attributes attached to the context by With.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs := attrsFromContext(ctx); len(attrs) > 0 {
+		r = r.Clone()
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
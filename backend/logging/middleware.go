@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// Middleware assigns each request an ID, attaches it to the request context and logs the request when it completes.
+// A valid X-Request-ID header from a reverse proxy is kept. Query strings are not logged because they may contain
+// tokens, and routes with parameters are logged by their pattern, e.g. /output/:file.
+// Successful requests to quietPaths, such as health checks, are logged at the debug level.
+func Middleware(quietPaths ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			id := req.Header.Get(requestIDHeader)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+			c.Response().Header().Set(requestIDHeader, id)
+			ctx := With(req.Context(), "request_id", id)
+			c.SetRequest(req.WithContext(ctx))
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			path := c.Path()
+			if path == "" || path == "/*" {
+				path = req.URL.Path
+			}
+			status := c.Response().Status
+			level := slog.LevelInfo
+			if status >= 500 {
+				level = slog.LevelError
+			} else if slices.Contains(quietPaths, path) {
+				level = slog.LevelDebug
+			}
+			slog.Log(ctx, level, "request",
+				"method", req.Method,
+				"path", path,
+				"status", status,
+				"duration_ms", time.Since(start).Milliseconds(),
+				"bytes", c.Response().Size,
+				"remote_ip", c.RealIP(),
+			)
+			return nil
+		}
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// validRequestID reports whether a request ID from a client is safe to log.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
@@ -1,15 +1,15 @@
 package main
 
 import (
-	"database/sql"
 	"embed"
 	"flag"
-	"log"
-
-	_ "github.com/mattn/go-sqlite3"
+	"fmt"
+	"os"
 
 	"undef.ninja/x/feedaka/cmd"
 	"undef.ninja/x/feedaka/config"
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/logging"
 )
 
 //go:generate go tool sqlc generate
@@ -20,27 +20,81 @@ var (
 	publicFS embed.FS
 )
 
-func main() {
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		log.Fatal(err)
-	}
+func usage() {
+	fmt.Fprint(flag.CommandLine.Output(), `Usage: feedaka [global flags] [command]
 
+Commands:
+  serve    Run the server (default). Run "feedaka serve -h" for details.
+  worker   Fetch feeds on schedule without the server
+  fetch    Fetch the due feeds once, e.g. from cron. Run "feedaka fetch -h" for details.
+  migrate  Apply, list or revert database migrations. Run "feedaka migrate help" for details.
+  user     Manage users. Run "feedaka user" for details.
+  feeds    Manage the feeds of a user. Run "feedaka feeds" for details.
+  backup   Back up the SQLite database while the server is running. Run "feedaka backup -h" for details.
+  restore  Replace the SQLite database by a backup. Run "feedaka restore -h" for details.
+
+Global flags:
+`)
+	flag.PrintDefaults()
+}
+
+func main() {
 	// Parse command line flags
+	configFlags := config.RegisterFlags(flag.CommandLine)
 	var migrate = flag.Bool("migrate", false, "Run database migrations")
 	var createUser = flag.Bool("create-user", false, "Create a new user")
+	var printConfig = flag.Bool("print-config", false, "Print the effective configuration with secrets redacted and exit")
+	flag.Usage = usage
 	flag.Parse()
-	database, err := sql.Open("sqlite3", "data/feedaka.db")
+
+	cfg, err := configFlags.Load()
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
+	}
+	if err := logging.Setup(os.Stderr, cfg.LogFormat, cfg.LogLevel); err != nil {
+		logging.Fatal("Failed to set up logging", "error", err)
+	}
+
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			logging.Fatal("Failed to print configuration", "error", err)
+		}
+		return
+	}
+
+	database, err := db.Open(cfg.DatabaseDSN())
 	if err != nil {
-		log.Fatal(err)
+		logging.Fatal("Failed to open database", "error", err)
 	}
 	defer database.Close()
 
-	if *migrate {
-		cmd.RunMigrate(database)
-	} else if *createUser {
+	args := flag.Args()
+	switch {
+	case *migrate:
+		cmd.RunMigrate(database, nil)
+	case *createUser:
 		cmd.RunCreateUser(database)
-	} else {
-		cmd.RunServe(database, cfg, publicFS)
+	case len(args) == 0:
+		cmd.RunServe(database, cfg, publicFS, nil)
+	case args[0] == "serve":
+		cmd.RunServe(database, cfg, publicFS, args[1:])
+	case args[0] == "worker":
+		cmd.RunWorker(database, cfg, args[1:])
+	case args[0] == "fetch":
+		cmd.RunFetch(database, cfg, args[1:])
+	case args[0] == "migrate":
+		cmd.RunMigrate(database, args[1:])
+	case args[0] == "user":
+		cmd.RunUser(database, args[1:])
+	case args[0] == "feeds":
+		cmd.RunFeeds(database, cfg, args[1:])
+	case args[0] == "backup":
+		cmd.RunBackup(database, args[1:])
+	case args[0] == "restore":
+		cmd.RunRestore(database, cfg, args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", args[0])
+		flag.Usage()
+		os.Exit(2)
 	}
 }
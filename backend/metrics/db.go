@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+// instrumentedDB times the queries run through it.
+type instrumentedDB struct {
+	db db.DBTX
+}
+
+// InstrumentDB returns a db.DBTX that records the duration of each query in DBQueryDuration.
+func InstrumentDB(d db.DBTX) db.DBTX {
+	return &instrumentedDB{db: d}
+}
+
+func (d *instrumentedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observeQuery(query, time.Now())
+	return d.db.ExecContext(ctx, query, args...)
+}
+
+func (d *instrumentedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return d.db.PrepareContext(ctx, query)
+}
+
+func (d *instrumentedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observeQuery(query, time.Now())
+	return d.db.QueryContext(ctx, query, args...)
+}
+
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer observeQuery(query, time.Now())
+	return d.db.QueryRowContext(ctx, query, args...)
+}
+
+func observeQuery(query string, start time.Time) {
+	DBQueryDuration.WithLabelValues(queryName(query)).Observe(time.Since(start).Seconds())
+}
+
+// queryName extracts the name from the "-- name: GetFeed :one" comment sqlc puts at the beginning of queries.
+// Other queries are reported as "other" to keep the number of labels bounded.
+func queryName(query string) string {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "other"
+	}
+	name, _, ok := strings.Cut(rest, " ")
+	if !ok {
+		return "other"
+	}
+	return name
+}
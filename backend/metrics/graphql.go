@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// GraphQL is a gqlgen extension that records the duration and errors of queries and mutations.
+// Subscriptions are long-lived, so they are not timed.
+type GraphQL struct{}
+
+var _ interface {
+	gqlgen.HandlerExtension
+	gqlgen.ResponseInterceptor
+} = GraphQL{}
+
+func (GraphQL) ExtensionName() string {
+	return "Metrics"
+}
+
+func (GraphQL) Validate(gqlgen.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next gqlgen.ResponseHandler) *gqlgen.Response {
+	if !gqlgen.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	opCtx := gqlgen.GetOperationContext(ctx)
+	if opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	resp := next(ctx)
+
+	name := "anonymous"
+	if opCtx.Operation != nil && opCtx.Operation.Name != "" {
+		name = opCtx.Operation.Name
+	}
+	GraphQLDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	if resp != nil && len(resp.Errors) > 0 {
+		GraphQLErrors.WithLabelValues(name).Inc()
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "feedaka"
+
+// Outcomes of fetch requests
+const (
+	OutcomeSuccess   = "success"
+	OutcomeHTTPError = "http_error"
+	OutcomeTimeout   = "timeout"
+	OutcomeTooLarge  = "too_large"
+	OutcomeError     = "error"
+)
+
+// The default registry also exports Go runtime and process metrics.
+var (
+	FetchRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "fetch_requests_total",
+		Help:      "Requests made to fetch feeds and pages, by outcome.",
+	}, []string{"outcome"})
+
+	FetchDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "fetch_duration_seconds",
+		Help:      "Time taken to fetch a feed or page, including reading the response.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	})
+
+	FetchBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "fetch_bytes_total",
+		Help:      "Bytes downloaded when fetching feeds and pages.",
+	})
+
+	SyncNewArticles = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sync_new_articles",
+		Help:      "Number of new articles added by each feed sync.",
+		Buckets:   []float64{0, 1, 2, 5, 10, 20, 50, 100},
+	})
+
+	FetchQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "fetch_queue_depth",
+		Help:      "Number of feeds queued to be fetched.",
+	})
+
+	FetchQueueWait = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "fetch_queue_wait_seconds",
+		Help:      "Time a refresh job waited in the queue before a worker took it.",
+		Buckets:   []float64{0.01, 0.1, 1, 5, 15, 60, 300, 900, 3600},
+	})
+
+	GraphQLDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_operation_duration_seconds",
+		Help:      "Time taken to execute GraphQL queries and mutations, by operation name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	GraphQLErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "graphql_operation_errors_total",
+		Help:      "GraphQL responses with errors, by operation name.",
+	}, []string{"operation"})
+
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Time taken to execute database queries, by sqlc query name.",
+		Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 1},
+	}, []string{"query"})
+)
+
+// Handler serves the metrics in the Prometheus text format. If token is not empty, scrapers must send it
+// as "Authorization: Bearer <token>".
+func Handler(token string) echo.HandlerFunc {
+	h := promhttp.Handler()
+	return func(c echo.Context) error {
+		if token != "" {
+			expected := "Bearer " + token
+			if subtle.ConstantTimeCompare([]byte(c.Request().Header.Get("Authorization")), []byte(expected)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+		}
+		h.ServeHTTP(c.Response(), c.Request())
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package output
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/labstack/echo/v4"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/sanitize"
+)
+
+const (
+	SourceStarred = "starred"
+	SourceFeed    = "feed"
+	SourceFolder  = "folder"
+	SourceSearch  = "search"
+)
+
+// Maximum number of articles in a generated feed
+const itemsLimit = 50
+
+func IsValidSource(source string) bool {
+	switch source {
+	case SourceStarred, SourceFeed, SourceFolder, SourceSearch:
+		return true
+	}
+	return false
+}
+
+// GenerateToken returns a random token that makes the URL of an output feed unguessable.
+func GenerateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SearchPattern returns the LIKE pattern matching titles that contain query.
+// "%" and "_" in the query act as wildcards.
+func SearchPattern(query string) string {
+	return "%" + strings.ToLower(query) + "%"
+}
+
+type Handler struct {
+	queries db.Store
+	baseURL string
+}
+
+// NewHandler creates a handler serving output feeds. If baseURL is empty, links are built from the request.
+func NewHandler(queries db.Store, baseURL string) *Handler {
+	return &Handler{
+		queries: queries,
+		baseURL: baseURL,
+	}
+}
+
+// Handle serves /output/:file, where file is "<token>.rss", "<token>.atom" or "<token>.json".
+func (h *Handler) Handle(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	token, format, ok := strings.Cut(c.Param("file"), ".")
+	if !ok {
+		return echo.ErrNotFound
+	}
+	of, err := h.queries.GetOutputFeedByToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return echo.ErrNotFound
+		}
+		return err
+	}
+
+	var articles []db.Article
+	switch of.Source {
+	case SourceStarred, SourceFeed, SourceFolder:
+		arg := db.GetStreamArticlesParams{
+			UserID:          of.UserID,
+			FeedID:          of.FeedID.Int64,
+			FolderID:        of.FolderID.Int64,
+			IsRead:          -1,
+			MinID:           0,
+			MaxID:           math.MaxInt64,
+			PublishedSince:  "",
+			PublishedBefore: "9",
+			Limit:           itemsLimit,
+		}
+		if of.Source == SourceStarred {
+			arg.StarredOnly = 1
+		}
+		articles, err = h.queries.GetStreamArticles(ctx, arg)
+	case SourceSearch:
+		articles, err = h.queries.SearchArticles(ctx, db.SearchArticlesParams{
+			UserID:  of.UserID,
+			Pattern: SearchPattern(of.Query),
+			Limit:   itemsLimit,
+		})
+	default:
+		return fmt.Errorf("unknown output feed source: %s", of.Source)
+	}
+	if err != nil {
+		return err
+	}
+
+	baseURL := h.baseURL
+	if baseURL == "" {
+		baseURL = c.Scheme() + "://" + c.Request().Host
+	}
+	f := build(of, articles, baseURL)
+
+	var body, contentType string
+	switch format {
+	case "rss":
+		body, err = f.ToRss()
+		contentType = "application/rss+xml; charset=utf-8"
+	case "atom":
+		body, err = f.ToAtom()
+		contentType = "application/atom+xml; charset=utf-8"
+	case "json":
+		body, err = f.ToJSON()
+		contentType = "application/feed+json; charset=utf-8"
+	default:
+		return echo.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render output feed: %w", err)
+	}
+	return c.Blob(http.StatusOK, contentType, []byte(body))
+}
+
+func build(of db.OutputFeed, articles []db.Article, baseURL string) *feeds.Feed {
+	f := &feeds.Feed{
+		Title: of.Title,
+		Link:  &feeds.Link{Href: baseURL + "/"},
+		Id:    fmt.Sprintf("%s/output/%d", baseURL, of.ID),
+	}
+	for _, a := range articles {
+		published, _ := time.Parse(time.RFC3339, a.PublishedAt)
+		f.Items = append(f.Items, &feeds.Item{
+			Title:   a.Title,
+			Link:    &feeds.Link{Href: a.Url},
+			Id:      a.Guid,
+			Created: published,
+			Content: sanitize.HTML(a.Content, a.Url),
+		})
+		if published.After(f.Updated) {
+			f.Updated = published
+		}
+	}
+	if f.Updated.IsZero() {
+		f.Updated, _ = time.Parse(time.RFC3339, of.CreatedAt)
+	}
+	return f
+}
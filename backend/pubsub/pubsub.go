@@ -0,0 +1,96 @@
+package pubsub
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"undef.ninja/x/feedaka/db"
+)
+
+type EventType int
+
+const (
+	// A new article was added to a feed
+	ArticleAdded EventType = iota
+	// A feed was fetched or its articles changed
+	FeedUpdated
+	// The number of unread articles of the user changed
+	UnreadCountChanged
+	// A refresh job was queued or its progress changed
+	RefreshJobUpdated
+)
+
+type Event struct {
+	Type      EventType
+	FeedID    int64
+	ArticleID int64
+	JobID     int64
+}
+
+// Number of events buffered per subscriber before further events are dropped.
+const bufferSize = 64
+
+// Bus is an in-process pub/sub bus. Events are scoped to the user they belong to.
+type Bus struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan Event]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subscribers: make(map[int64]map[chan Event]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives the user's events. The channel is closed when ctx is done.
+func (b *Bus) Subscribe(ctx context.Context, userID int64) <-chan Event {
+	ch := make(chan Event, bufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan Event]struct{})
+	}
+	b.subscribers[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers[userID], ch)
+		if len(b.subscribers[userID]) == 0 {
+			delete(b.subscribers, userID)
+		}
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Publish sends the event to all subscribers of the user.
+// Slow subscribers miss the event instead of blocking the publisher.
+func (b *Bus) Publish(userID int64, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[userID] {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Dropped event because the subscriber is too slow", "user_id", userID)
+		}
+	}
+}
+
+// PublishFeedSynced publishes the events caused by syncing a feed with Fetcher.Sync.
+func (b *Bus) PublishFeedSynced(userID, feedID int64, added []db.Article) {
+	for _, article := range added {
+		b.Publish(userID, Event{Type: ArticleAdded, FeedID: feedID, ArticleID: article.ID})
+	}
+	b.Publish(userID, Event{Type: FeedUpdated, FeedID: feedID})
+	if len(added) > 0 {
+		b.Publish(userID, Event{Type: UnreadCountChanged})
+	}
+}
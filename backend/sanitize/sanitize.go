@@ -0,0 +1,258 @@
+package sanitize
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Hosts whose pages may be embedded with <iframe>, e.g. video players
+var allowedIFrameHosts = map[string]bool{
+	"www.youtube.com":          true,
+	"youtube.com":              true,
+	"www.youtube-nocookie.com": true,
+	"player.vimeo.com":         true,
+	"w.soundcloud.com":         true,
+	"open.spotify.com":         true,
+	"embed.podcasts.apple.com": true,
+	"www.dailymotion.com":      true,
+	"bandcamp.com":             true,
+}
+
+// Hosts that only serve tracking pixels and web bugs
+var trackerHosts = map[string]bool{
+	"feeds.feedburner.com":     true,
+	"pixel.wp.com":             true,
+	"stats.wordpress.com":      true,
+	"www.google-analytics.com": true,
+	"pixel.quantserve.com":     true,
+	"feeds.feedblitz.com":      true,
+	"pixel.mathtag.com":        true,
+	"ad.doubleclick.net":       true,
+	"pi.feedsportal.com":       true,
+	"rss.buysellads.com":       true,
+}
+
+// Attributes holding URLs, which are resolved against the base URL
+var urlAttrs = map[string]bool{
+	"href":   true,
+	"src":    true,
+	"poster": true,
+	"cite":   true,
+}
+
+// Sandbox tokens given to allowed iframes so that embedded players keep working
+const iframeSandbox = "allow-scripts allow-same-origin allow-popups allow-presentation"
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("src", "width", "height", "allowfullscreen", "sandbox").OnElements("iframe")
+	p.RequireSandboxOnIFrame(bluemonday.SandboxAllowScripts, bluemonday.SandboxAllowSameOrigin, bluemonday.SandboxAllowPopups, bluemonday.SandboxAllowPresentation)
+	p.AllowAttrs("srcset").OnElements("img")
+	p.AllowAttrs("src", "srcset", "type").OnElements("source")
+	p.AllowAttrs("src", "poster", "controls", "width", "height").OnElements("video")
+	p.AllowAttrs("src", "controls").OnElements("audio")
+	p.AllowElements("video", "audio", "source", "picture", "figure", "figcaption")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(false)
+	p.RequireNoReferrerOnFullyQualifiedLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// HTML sanitizes feed-supplied HTML with an allowlist policy. It removes scripts, event handlers,
+// iframes outside the allowlist and tracking pixels, and resolves relative URLs against baseURL.
+// Sanitizing sanitized HTML does not change it further.
+func HTML(content, baseURL string) string {
+	if content == "" {
+		return ""
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil || !base.IsAbs() {
+		base = nil
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return policy.Sanitize(content)
+	}
+
+	var sb strings.Builder
+	for _, n := range nodes {
+		if !clean(n, base) {
+			continue
+		}
+		if err := html.Render(&sb, n); err != nil {
+			return policy.Sanitize(content)
+		}
+	}
+	return policy.Sanitize(sb.String())
+}
+
+// clean resolves the URLs in n and its descendants and removes unwanted descendants.
+// It returns false if n itself should be removed.
+func clean(n *html.Node, base *url.URL) bool {
+	if n.Type != html.ElementNode {
+		return true
+	}
+
+	for i, attr := range n.Attr {
+		if urlAttrs[attr.Key] {
+			n.Attr[i].Val = resolve(base, attr.Val)
+		} else if attr.Key == "srcset" {
+			n.Attr[i].Val = resolveSrcset(base, attr.Val)
+		}
+	}
+
+	switch n.DataAtom {
+	case atom.Iframe:
+		if !isAllowedIFrame(attrValue(n, "src")) {
+			return false
+		}
+		setAttr(n, "sandbox", iframeSandbox)
+	case atom.Img:
+		if isTrackingPixel(n) {
+			return false
+		}
+	}
+
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if !clean(c, base) {
+			n.RemoveChild(c)
+		}
+		c = next
+	}
+	return true
+}
+
+func attrValue(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, attr := range n.Attr {
+		if attr.Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
+
+func resolve(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if base == nil || ref == "" || strings.HasPrefix(ref, "#") {
+		return ref
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+// resolveSrcset resolves the URLs in a srcset attribute, e.g. "a.png 1x, b.png 2x".
+func resolveSrcset(base *url.URL, srcset string) string {
+	return rewriteSrcset(srcset, func(ref string) string {
+		return resolve(base, ref)
+	})
+}
+
+func rewriteSrcset(srcset string, fn func(string) string) string {
+	candidates := strings.Split(srcset, ",")
+	for i, candidate := range candidates {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		fields[0] = fn(fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(candidates, ", ")
+}
+
+func isAllowedIFrame(src string) bool {
+	u, err := url.Parse(src)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	return allowedIFrameHosts[strings.ToLower(u.Hostname())]
+}
+
+// isTrackingPixel reports whether the image is a web bug: a 1x1 image or one served by a known tracker.
+func isTrackingPixel(n *html.Node) bool {
+	width, errW := strconv.Atoi(strings.TrimSuffix(attrValue(n, "width"), "px"))
+	height, errH := strconv.Atoi(strings.TrimSuffix(attrValue(n, "height"), "px"))
+	if errW == nil && errH == nil && width <= 1 && height <= 1 {
+		return true
+	}
+
+	u, err := url.Parse(attrValue(n, "src"))
+	if err != nil {
+		return false
+	}
+	return trackerHosts[strings.ToLower(u.Hostname())]
+}
+
+// RewriteImages replaces the URLs of images in content, including srcset candidates and video posters, with fn(url).
+func RewriteImages(content string, fn func(string) string) string {
+	if content == "" {
+		return ""
+	}
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return content
+	}
+
+	var sb strings.Builder
+	for _, n := range nodes {
+		rewriteImages(n, fn)
+		if err := html.Render(&sb, n); err != nil {
+			return content
+		}
+	}
+	return sb.String()
+}
+
+func rewriteImages(n *html.Node, fn func(string) string) {
+	if n.Type == html.ElementNode {
+		for i, attr := range n.Attr {
+			switch {
+			case attr.Key == "src" && (n.DataAtom == atom.Img || isPictureSource(n)):
+				n.Attr[i].Val = fn(attr.Val)
+			case attr.Key == "srcset" && (n.DataAtom == atom.Img || isPictureSource(n)):
+				n.Attr[i].Val = rewriteSrcset(attr.Val, fn)
+			case attr.Key == "poster" && n.DataAtom == atom.Video:
+				n.Attr[i].Val = fn(attr.Val)
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		rewriteImages(c, fn)
+	}
+}
+
+// isPictureSource reports whether n is a <source> of a <picture>, as opposed to one of a <video> or <audio>.
+func isPictureSource(n *html.Node) bool {
+	return n.DataAtom == atom.Source && n.Parent != nil && n.Parent.DataAtom == atom.Picture
+}
@@ -0,0 +1,386 @@
+package websub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/labstack/echo/v4"
+	"github.com/mmcdole/gofeed"
+
+	"undef.ninja/x/feedaka/db"
+	"undef.ninja/x/feedaka/feed"
+	"undef.ninja/x/feedaka/logging"
+	"undef.ninja/x/feedaka/pubsub"
+)
+
+const (
+	statePending       = "pending"
+	stateActive        = "active"
+	stateUnsubscribing = "unsubscribing"
+	stateDenied        = "denied"
+)
+
+const (
+	// Lease requested from hubs. Hubs may grant a different one.
+	leaseSeconds = 7 * 24 * 60 * 60
+	// Leases are renewed when they expire within this duration.
+	renewMargin = 24 * time.Hour
+	// Subscriptions that were never verified or were denied are retried after this duration.
+	retryInterval = 24 * time.Hour
+	// Maximum size of content pushed by hubs.
+	maxContentLength = 10 * 1024 * 1024
+)
+
+// Subscriber manages WebSub subscriptions and handles hub callbacks.
+// A nil *Subscriber means WebSub is disabled; Subscribe and Unsubscribe do nothing.
+type Subscriber struct {
+	queries db.Store
+	bus     *pubsub.Bus
+	baseURL string
+	client  *http.Client
+	fetcher *feed.Fetcher
+}
+
+func NewSubscriber(queries db.Store, bus *pubsub.Bus, baseURL string, fetcher *feed.Fetcher) *Subscriber {
+	return &Subscriber{
+		queries: queries,
+		bus:     bus,
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		fetcher: fetcher,
+	}
+}
+
+// IsPushActive reports whether a feed has a verified subscription whose lease has not expired.
+func IsPushActive(state, leaseExpiresAt string, now time.Time) bool {
+	if state != stateActive {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, leaseExpiresAt)
+	if err != nil {
+		return false
+	}
+	return now.Before(expiresAt)
+}
+
+func (s *Subscriber) callbackURL(feedID int64) string {
+	return fmt.Sprintf("%s/websub/callback/%d", s.baseURL, feedID)
+}
+
+// Subscribe subscribes to the hub advertised by the feed, if any.
+// It does nothing if the feed is already subscribed to the same hub and topic.
+func (s *Subscriber) Subscribe(ctx context.Context, feedID int64, feedURL string, f *gofeed.Feed) error {
+	if s == nil {
+		return nil
+	}
+
+	hubs := feed.Links(f, "hub")
+	if len(hubs) == 0 {
+		return nil
+	}
+	hubURL := hubs[0]
+	topicURL := feedURL
+	if selfs := feed.Links(f, "self"); len(selfs) > 0 {
+		topicURL = selfs[0]
+	}
+
+	sub, err := s.queries.GetWebSubSubscription(ctx, feedID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil && sub.HubUrl == hubURL && sub.TopicUrl == topicURL {
+		switch sub.State {
+		case stateActive:
+			// Renewed by RenewExpiring
+			return nil
+		case statePending, stateDenied:
+			requestedAt, err := time.Parse(time.RFC3339, sub.RequestedAt)
+			if err == nil && time.Since(requestedAt) < retryInterval {
+				return nil
+			}
+		}
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return err
+	}
+	return s.subscribe(ctx, feedID, hubURL, topicURL, secret, statePending)
+}
+
+func (s *Subscriber) subscribe(ctx context.Context, feedID int64, hubURL, topicURL, secret, state string) error {
+	err := s.queries.UpsertWebSubSubscription(ctx, db.UpsertWebSubSubscriptionParams{
+		FeedID:      feedID,
+		HubUrl:      hubURL,
+		TopicUrl:    topicURL,
+		Secret:      secret,
+		State:       state,
+		RequestedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Subscribing via WebSub hub", "feed_id", feedID, "topic", logging.URL(topicURL), "hub", logging.URL(hubURL))
+	return s.request(ctx, hubURL, url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {topicURL},
+		"hub.callback":      {s.callbackURL(feedID)},
+		"hub.secret":        {secret},
+		"hub.lease_seconds": {strconv.Itoa(leaseSeconds)},
+	})
+}
+
+// Unsubscribe asks the hub to stop pushing the feed. The subscription is removed once the hub verifies it.
+func (s *Subscriber) Unsubscribe(ctx context.Context, feedID int64) error {
+	if s == nil {
+		return nil
+	}
+
+	sub, err := s.queries.GetWebSubSubscription(ctx, feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	err = s.queries.UpdateWebSubSubscriptionState(ctx, db.UpdateWebSubSubscriptionStateParams{
+		State:  stateUnsubscribing,
+		FeedID: feedID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.request(ctx, sub.HubUrl, url.Values{
+		"hub.mode":     {"unsubscribe"},
+		"hub.topic":    {sub.TopicUrl},
+		"hub.callback": {s.callbackURL(feedID)},
+	})
+}
+
+// RenewExpiring renews leases that are about to expire and retries subscriptions that were never verified.
+func (s *Subscriber) RenewExpiring(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	subs, err := s.queries.GetWebSubSubscriptionsToRenew(ctx, db.GetWebSubSubscriptionsToRenewParams{
+		RenewBefore: sql.NullString{String: now.Add(renewMargin).Format(time.RFC3339), Valid: true},
+		RetryBefore: now.Add(-retryInterval).Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, sub := range subs {
+		// Keep the secret and the state so that pushes keep being accepted while the renewal is verified.
+		err := s.subscribe(ctx, sub.FeedID, sub.HubUrl, sub.TopicUrl, sub.Secret, sub.State)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("feed %d: %w", sub.FeedID, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func (s *Subscriber) request(ctx context.Context, hubURL string, form url.Values) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send %s request to %s: %w", form.Get("hub.mode"), hubURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("hub %s rejected %s request: %s: %s", hubURL, form.Get("hub.mode"), resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func (s *Subscriber) getSubscription(c echo.Context) (db.WebsubSubscription, error) {
+	feedID, err := strconv.ParseInt(c.Param("feedId"), 10, 64)
+	if err != nil {
+		return db.WebsubSubscription{}, echo.ErrNotFound
+	}
+	sub, err := s.queries.GetWebSubSubscription(c.Request().Context(), feedID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.WebsubSubscription{}, echo.ErrNotFound
+		}
+		return db.WebsubSubscription{}, err
+	}
+	return sub, nil
+}
+
+// HandleVerify answers the hub's verification of intent for subscribe and unsubscribe requests.
+func (s *Subscriber) HandleVerify(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	sub, err := s.getSubscription(c)
+	if err != nil {
+		return err
+	}
+	ctx = logging.With(ctx, "feed_id", sub.FeedID)
+	if c.QueryParam("hub.topic") != sub.TopicUrl {
+		return echo.ErrNotFound
+	}
+
+	challenge := c.QueryParam("hub.challenge")
+	switch c.QueryParam("hub.mode") {
+	case "subscribe":
+		if sub.State == stateUnsubscribing {
+			return echo.ErrNotFound
+		}
+		lease, err := strconv.Atoi(c.QueryParam("hub.lease_seconds"))
+		if err != nil || lease <= 0 {
+			lease = leaseSeconds
+		}
+		err = s.queries.ActivateWebSubSubscription(ctx, db.ActivateWebSubSubscriptionParams{
+			LeaseExpiresAt: sql.NullString{
+				String: time.Now().UTC().Add(time.Duration(lease) * time.Second).Format(time.RFC3339),
+				Valid:  true,
+			},
+			FeedID: sub.FeedID,
+		})
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "WebSub subscription verified", "lease_seconds", lease)
+		return c.String(http.StatusOK, challenge)
+	case "unsubscribe":
+		if sub.State != stateUnsubscribing {
+			return echo.ErrNotFound
+		}
+		if err := s.queries.DeleteWebSubSubscription(ctx, sub.FeedID); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "WebSub subscription removed")
+		return c.String(http.StatusOK, challenge)
+	case "denied":
+		err := s.queries.UpdateWebSubSubscriptionState(ctx, db.UpdateWebSubSubscriptionStateParams{
+			State:  stateDenied,
+			FeedID: sub.FeedID,
+		})
+		if err != nil {
+			return err
+		}
+		slog.WarnContext(ctx, "WebSub subscription denied", "reason", c.QueryParam("hub.reason"))
+		return c.NoContent(http.StatusOK)
+	default:
+		return echo.ErrBadRequest
+	}
+}
+
+// HandleNotify ingests content pushed by the hub.
+func (s *Subscriber) HandleNotify(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	sub, err := s.getSubscription(c)
+	if err != nil {
+		return err
+	}
+	ctx = logging.With(ctx, "feed_id", sub.FeedID)
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxContentLength+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > maxContentLength {
+		return echo.ErrStatusRequestEntityTooLarge
+	}
+
+	// Per the spec, notifications with an invalid signature are acknowledged but ignored.
+	if !verifySignature(sub.Secret, c.Request().Header.Get("X-Hub-Signature"), body) {
+		slog.WarnContext(ctx, "Ignoring WebSub notification with an invalid signature")
+		return c.NoContent(http.StatusOK)
+	}
+
+	dbFeed, err := s.queries.GetFeed(ctx, sub.FeedID)
+	if err != nil {
+		return err
+	}
+	if dbFeed.IsSubscribed != 1 {
+		return c.NoContent(http.StatusOK)
+	}
+
+	f, err := feed.Parse(bytes.NewReader(body))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to parse WebSub notification", "error", err)
+		return echo.ErrBadRequest
+	}
+	// Some hubs push only the new entries without the feed metadata.
+	if f.Title == "" {
+		f.Title = dbFeed.Title
+	}
+
+	added, err := s.fetcher.Sync(ctx, s.queries, sub.FeedID, f)
+	if err != nil {
+		return err
+	}
+	s.bus.PublishFeedSynced(dbFeed.UserID, sub.FeedID, added)
+	slog.InfoContext(ctx, "Received WebSub notification", "items", len(f.Items))
+	return c.NoContent(http.StatusOK)
+}
+
+func verifySignature(secret, header string, body []byte) bool {
+	method, signature, ok := strings.Cut(header, "=")
+	if !ok {
+		return false
+	}
+
+	var h func() hash.Hash
+	switch method {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
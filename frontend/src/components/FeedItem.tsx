@@ -44,8 +44,15 @@ export function FeedItem({ feed, onFeedUnsubscribed }: Props) {
 		<div className="group rounded-xl border border-stone-200 bg-white p-5 transition-all duration-200 hover:border-stone-300 hover:shadow-sm">
 			<div className="flex items-start justify-between gap-4">
 				<div className="min-w-0 flex-1">
-					<h3 className="truncate text-base font-semibold text-stone-900">
-						{feed.title}
+					<h3 className="flex items-center gap-2 text-base font-semibold text-stone-900">
+						{feed.iconUrl && (
+							<img
+								src={feed.iconUrl}
+								alt=""
+								className="h-4 w-4 shrink-0 rounded-sm"
+							/>
+						)}
+						<span className="truncate">{feed.title}</span>
 					</h3>
 					<a
 						href={feed.url}
@@ -17,8 +17,31 @@ export type Scalars = {
   DateTime: { input: string; output: string; }
 };
 
+/** Personal API token for scripts and third-party clients. It is sent as "Authorization: Bearer <token>". */
+export type ApiToken = {
+  /** Timestamp when the token was created */
+  createdAt: Scalars['DateTime']['output'];
+  /** Timestamp when the token expires. Null means it never expires. */
+  expiresAt?: Maybe<Scalars['DateTime']['output']>;
+  /** Unique identifier for the API token */
+  id: Scalars['ID']['output'];
+  /** Timestamp when the token was last used */
+  lastUsedAt?: Maybe<Scalars['DateTime']['output']>;
+  /** Name to tell tokens apart */
+  name: Scalars['String']['output'];
+  /** What the token is allowed to do */
+  scope: ApiTokenScope;
+};
+
+/** What an API token is allowed to do */
+export type ApiTokenScope =
+  | 'READ_ONLY'
+  | 'READ_WRITE';
+
 /** Represents an individual article/post from a feed */
 export type Article = {
+  /** HTML content of the article, from the feed or extracted from the article page */
+  content: Scalars['String']['output'];
   /** The feed this article belongs to */
   feed: Feed;
   /** ID of the feed this article belongs to */
@@ -41,26 +64,146 @@ export type AuthPayload = {
   user: User;
 };
 
+/** Input for creating an API token */
+export type CreateApiTokenInput = {
+  /** Timestamp when the token expires. Null means it never expires. */
+  expiresAt?: InputMaybe<Scalars['DateTime']['input']>;
+  /** Name to tell tokens apart */
+  name: Scalars['String']['input'];
+  /** What the token is allowed to do */
+  scope: ApiTokenScope;
+};
+
+/** Payload returned from createApiToken mutation */
+export type CreateApiTokenPayload = {
+  /** The created API token */
+  apiToken: ApiToken;
+  /** The secret token. It is not stored and cannot be retrieved again. */
+  token: Scalars['String']['output'];
+};
+
+/** Input for creating an output feed */
+export type CreateOutputFeedInput = {
+  /** ID of the feed whose articles are included. Required if source is FEED. */
+  feedId?: InputMaybe<Scalars['ID']['input']>;
+  /** ID of the folder whose feeds' articles are included. Required if source is FOLDER. */
+  folderId?: InputMaybe<Scalars['ID']['input']>;
+  /** Search query matched against article titles. Required if source is SEARCH. "%" and "_" act as wildcards. */
+  query?: InputMaybe<Scalars['String']['input']>;
+  /** Which articles the output feed contains */
+  source: OutputFeedSource;
+  /** Title of the generated feed */
+  title: Scalars['String']['input'];
+};
+
+/** How often a digest email is sent */
+export type DigestFrequency =
+  | 'OFF'
+  | 'DAILY'
+  | 'WEEKLY';
+
+/** Settings for the email digest of new unread articles */
+export type DigestSettings = {
+  /** Email address the digest is sent to */
+  email: Scalars['String']['output'];
+  /** IDs of the feeds included in the digest. If both feedIds and folderIds are empty, all feeds are included. */
+  feedIds: Array<Scalars['ID']['output']>;
+  /** IDs of the folders whose feeds are included in the digest */
+  folderIds: Array<Scalars['ID']['output']>;
+  /** How often the digest is sent */
+  frequency: DigestFrequency;
+  /** Minimum number of new articles required to send a digest */
+  minArticles: Scalars['Int']['output'];
+};
+
+/** Input for updating digest settings */
+export type DigestSettingsInput = {
+  /** Email address the digest is sent to */
+  email: Scalars['String']['input'];
+  /** IDs of the feeds included in the digest. If both feedIds and folderIds are empty, all feeds are included. */
+  feedIds: Array<Scalars['ID']['input']>;
+  /** IDs of the folders whose feeds are included in the digest */
+  folderIds?: InputMaybe<Array<Scalars['ID']['input']>>;
+  /** How often the digest is sent */
+  frequency: DigestFrequency;
+  /** Minimum number of new articles required to send a digest */
+  minArticles: Scalars['Int']['input'];
+};
+
 /** Represents a feed subscription in the system */
 export type Feed = {
   /** Articles belonging to this feed */
   articles: Array<Article>;
+  /** Whether the full content of new articles is extracted from their pages */
+  fetchFullContent: Scalars['Boolean']['output'];
+  /** Timeout in seconds of requests fetching the feed, or null if the server's default is used */
+  fetchTimeoutSeconds?: Maybe<Scalars['Int']['output']>;
   /** Timestamp when the feed was last fetched */
   fetchedAt: Scalars['DateTime']['output'];
+  /** ID of the folder containing the feed, or null if it is in no folder */
+  folderId?: Maybe<Scalars['ID']['output']>;
+  /** Whether credentials are sent when fetching the feed. The credentials themselves are never returned. */
+  hasCredentials: Scalars['Boolean']['output'];
+  /** URL of the site's icon, or null if none was found */
+  iconUrl?: Maybe<Scalars['String']['output']>;
   /** Unique identifier for the feed */
   id: Scalars['ID']['output'];
   /** Whether the user is currently subscribed to this feed */
   isSubscribed: Scalars['Boolean']['output'];
+  /** Selectors used to scrape the page at url, or null if this is a regular RSS/Atom feed */
+  scrapeSelectors?: Maybe<ScrapeSelectors>;
   /** Title of the feed (extracted from feed metadata) */
   title: Scalars['String']['output'];
   /** URL of the RSS/Atom feed */
   url: Scalars['String']['output'];
 };
 
+/** Credentials sent with every request for a private feed. They are stored encrypted and never returned by the API. */
+export type FeedCredentialsInput = {
+  /** Token sent in the "Authorization: Bearer" header */
+  bearerToken?: InputMaybe<Scalars['String']['input']>;
+  /** Value of the Cookie header */
+  cookie?: InputMaybe<Scalars['String']['input']>;
+  /** Additional request headers */
+  headers?: InputMaybe<Array<HttpHeaderInput>>;
+  /** Password for HTTP basic authentication */
+  password?: InputMaybe<Scalars['String']['input']>;
+  /** Username for HTTP basic authentication */
+  username?: InputMaybe<Scalars['String']['input']>;
+};
+
+/** Folder grouping feeds. A feed is in at most one folder. */
+export type Folder = {
+  /** Subscribed feeds in the folder */
+  feeds: Array<Feed>;
+  /** Unique identifier for the folder */
+  id: Scalars['ID']['output'];
+  /** Name of the folder, unique per user */
+  name: Scalars['String']['output'];
+};
+
+/** An HTTP request header */
+export type HttpHeaderInput = {
+  name: Scalars['String']['input'];
+  value: Scalars['String']['input'];
+};
+
 /** Root mutation type for modifying data */
 export type Mutation = {
   /** Add a new feed subscription */
   addFeed: Feed;
+  /** Add a feed scraped from a page that has no RSS/Atom feed */
+  addScrapedFeed: Feed;
+  /** Create a personal API token. Requires a session, not an API token. */
+  createApiToken: CreateApiTokenPayload;
+  /** Create a folder */
+  createFolder: Folder;
+  /** Create a feed generated from the user's articles */
+  createOutputFeed: OutputFeed;
+  /** Delete a folder. Its feeds are kept and are no longer in any folder. Output feeds of the folder are deleted. */
+  deleteFolder: Scalars['Boolean']['output'];
+  /** Delete an output feed. Its URL stops working. */
+  deleteOutputFeed: Scalars['Boolean']['output'];
   /** Login with username and password. Creates a session cookie. */
   login: AuthPayload;
   /** Logout the current user and destroy the session */
@@ -73,17 +216,76 @@ export type Mutation = {
   markFeedRead: Feed;
   /** Mark all articles in a feed as unread */
   markFeedUnread: Feed;
+  /** Extract the full content of an article from its page again */
+  refetchArticleContent: Article;
+  /** Fetch all subscribed feeds now. Feeds that are already queued are left to their jobs. */
+  refreshAll: RefreshJob;
+  /** Fetch a feed now. If the feed is already queued, the job that fetches it is returned. */
+  refreshFeed: RefreshJob;
+  /** Fetch the subscribed feeds in a folder now. Feeds that are already queued are left to their jobs. */
+  refreshFolder: RefreshJob;
+  /** Rename a folder */
+  renameFolder: Folder;
+  /** Revoke a personal API token. Requires a session, not an API token. */
+  revokeApiToken: Scalars['Boolean']['output'];
+  /** Move a feed into a folder. Pass null to take it out of its folder. */
+  setFeedFolder: Feed;
+  /** Set the password used by Fever API clients, which authenticate with md5("username:password"). Pass null to disable the Fever API for the current user. Requires a session, not an API token. */
+  setFeverPassword: Scalars['Boolean']['output'];
   /** Unsubscribe from a feed (preserves feed and article data) */
   unsubscribeFeed: Scalars['Boolean']['output'];
+  /** Update the digest email settings of the current user */
+  updateDigestSettings: DigestSettings;
+  /** Update the settings of a feed */
+  updateFeed: Feed;
 };
 
 
 /** Root mutation type for modifying data */
 export type MutationAddFeedArgs = {
+  credentials?: InputMaybe<FeedCredentialsInput>;
+  url: Scalars['String']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationAddScrapedFeedArgs = {
+  credentials?: InputMaybe<FeedCredentialsInput>;
+  selectors: ScrapeSelectorsInput;
   url: Scalars['String']['input'];
 };
 
 
+/** Root mutation type for modifying data */
+export type MutationCreateApiTokenArgs = {
+  input: CreateApiTokenInput;
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationCreateFolderArgs = {
+  name: Scalars['String']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationCreateOutputFeedArgs = {
+  input: CreateOutputFeedInput;
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationDeleteFolderArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationDeleteOutputFeedArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
 /** Root mutation type for modifying data */
 export type MutationLoginArgs = {
   password: Scalars['String']['input'];
@@ -115,23 +317,121 @@ export type MutationMarkFeedUnreadArgs = {
 };
 
 
+/** Root mutation type for modifying data */
+export type MutationRefetchArticleContentArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationRefreshFeedArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationRefreshFolderArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationRenameFolderArgs = {
+  id: Scalars['ID']['input'];
+  name: Scalars['String']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationRevokeApiTokenArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationSetFeverPasswordArgs = {
+  password?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationSetFeedFolderArgs = {
+  folderId?: InputMaybe<Scalars['ID']['input']>;
+  id: Scalars['ID']['input'];
+};
+
+
 /** Root mutation type for modifying data */
 export type MutationUnsubscribeFeedArgs = {
   id: Scalars['ID']['input'];
 };
 
+
+/** Root mutation type for modifying data */
+export type MutationUpdateDigestSettingsArgs = {
+  input: DigestSettingsInput;
+};
+
+
+/** Root mutation type for modifying data */
+export type MutationUpdateFeedArgs = {
+  id: Scalars['ID']['input'];
+  input: UpdateFeedInput;
+};
+
+/** Feed generated from the user's articles. It is served without authentication at /output/<token>.rss, /output/<token>.atom and /output/<token>.json. */
+export type OutputFeed = {
+  /** Timestamp when the output feed was created */
+  createdAt: Scalars['DateTime']['output'];
+  /** ID of the feed whose articles are included, if source is FEED */
+  feedId?: Maybe<Scalars['ID']['output']>;
+  /** ID of the folder whose feeds' articles are included, if source is FOLDER */
+  folderId?: Maybe<Scalars['ID']['output']>;
+  /** Unique identifier for the output feed */
+  id: Scalars['ID']['output'];
+  /** Search query matched against article titles, if source is SEARCH */
+  query?: Maybe<Scalars['String']['output']>;
+  /** Which articles the output feed contains */
+  source: OutputFeedSource;
+  /** Title of the generated feed */
+  title: Scalars['String']['output'];
+  /** Unguessable token in the URL of the output feed */
+  token: Scalars['String']['output'];
+};
+
+/** Which articles an output feed contains */
+export type OutputFeedSource =
+  | 'STARRED'
+  | 'FEED'
+  | 'FOLDER'
+  | 'SEARCH';
+
 /** Root query type for reading data */
 export type Query = {
+  /** Get the API tokens of the current user */
+  apiTokens: Array<ApiToken>;
   /** Get a specific article by ID */
   article?: Maybe<Article>;
   /** Get the currently authenticated user */
   currentUser?: Maybe<User>;
+  /** Get the digest email settings of the current user */
+  digestSettings?: Maybe<DigestSettings>;
   /** Get a specific feed by ID */
   feed?: Maybe<Feed>;
   /** Get all feeds with their metadata */
   feeds: Array<Feed>;
+  /** Get a specific folder by ID */
+  folder?: Maybe<Folder>;
+  /** Get all folders of the current user */
+  folders: Array<Folder>;
+  /** Get the output feeds of the current user */
+  outputFeeds: Array<OutputFeed>;
+  /** Scrape a page with the selectors without saving it, to try out selectors before adding the feed. Only pages on public addresses can be previewed. */
+  previewScrapedFeed: ScrapedFeedPreview;
   /** Get all read articles across all feeds */
   readArticles: Array<Article>;
+  /** Get a refresh job by ID */
+  refreshJob?: Maybe<RefreshJob>;
   /** Get all unread articles across all feeds */
   unreadArticles: Array<Article>;
 };
@@ -148,6 +448,137 @@ export type QueryFeedArgs = {
   id: Scalars['ID']['input'];
 };
 
+
+/** Root query type for reading data */
+export type QueryFolderArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+/** Root query type for reading data */
+export type QueryPreviewScrapedFeedArgs = {
+  credentials?: InputMaybe<FeedCredentialsInput>;
+  selectors: ScrapeSelectorsInput;
+  url: Scalars['String']['input'];
+};
+
+
+/** Root query type for reading data */
+export type QueryRefreshJobArgs = {
+  id: Scalars['ID']['input'];
+};
+
+/** Background job fetching feeds, returned by the refresh mutations. Finished jobs are kept for an hour. */
+export type RefreshJob = {
+  /** Timestamp when the job was queued */
+  createdAt: Scalars['DateTime']['output'];
+  /** Last error, if a feed could not be fetched */
+  error?: Maybe<Scalars['String']['output']>;
+  /** Number of feeds that could not be fetched so far */
+  failedCount: Scalars['Int']['output'];
+  /** IDs of the feeds the job fetches. Feeds that were already queued by another job are not included. */
+  feedIds: Array<Scalars['ID']['output']>;
+  /** Number of feeds fetched successfully so far */
+  fetchedCount: Scalars['Int']['output'];
+  /** Timestamp when the job finished */
+  finishedAt?: Maybe<Scalars['DateTime']['output']>;
+  /** Unique identifier for the job */
+  id: Scalars['ID']['output'];
+  /** Status of the job */
+  status: RefreshJobStatus;
+};
+
+/** Status of a refresh job */
+export type RefreshJobStatus =
+  | 'QUEUED'
+  | 'RUNNING'
+  | 'DONE'
+  | 'FAILED';
+
+/** CSS selectors that extract articles from a page that has no RSS/Atom feed */
+export type ScrapeSelectors = {
+  /** Selects the HTML content within an article */
+  content?: Maybe<Scalars['String']['output']>;
+  /** Selects the publication date within an article, read from its datetime attribute or its text */
+  date?: Maybe<Scalars['String']['output']>;
+  /** Selects the element of each article */
+  item: Scalars['String']['output'];
+  /** Selects the link within an article. Defaults to the article element if it is a link, or its first link. */
+  link?: Maybe<Scalars['String']['output']>;
+  /** Selects the title within an article. Defaults to the text of the link. */
+  title?: Maybe<Scalars['String']['output']>;
+};
+
+/** Input for the CSS selectors of a scraped feed. See ScrapeSelectors. */
+export type ScrapeSelectorsInput = {
+  content?: InputMaybe<Scalars['String']['input']>;
+  date?: InputMaybe<Scalars['String']['input']>;
+  item: Scalars['String']['input'];
+  link?: InputMaybe<Scalars['String']['input']>;
+  title?: InputMaybe<Scalars['String']['input']>;
+};
+
+/** Result of scraping a page without saving it as a feed */
+export type ScrapedFeedPreview = {
+  /** Articles extracted from the page */
+  items: Array<ScrapedItem>;
+  /** Title of the page, used as the feed title */
+  title: Scalars['String']['output'];
+};
+
+/** An article extracted from a page by previewScrapedFeed */
+export type ScrapedItem = {
+  /** Sanitized HTML content of the article */
+  content: Scalars['String']['output'];
+  /** Publication date, or null if none was selected or it could not be parsed */
+  publishedAt?: Maybe<Scalars['DateTime']['output']>;
+  /** Title of the article */
+  title: Scalars['String']['output'];
+  /** URL of the article */
+  url: Scalars['String']['output'];
+};
+
+/** Root subscription type for receiving live updates */
+export type Subscription = {
+  /** Notified when a new article is added to one of the user's feeds. Pass feedId or folderId to only receive the articles of that feed or of the feeds in that folder. */
+  articleAdded: Article;
+  /** Notified when a feed is fetched or its articles change */
+  feedUpdated: Feed;
+  /** Notified when the status or progress of a refresh job changes */
+  refreshJobUpdated: RefreshJob;
+  /** Notified with the new number of unread articles when it changes */
+  unreadCountChanged: Scalars['Int']['output'];
+};
+
+
+/** Root subscription type for receiving live updates */
+export type SubscriptionArticleAddedArgs = {
+  feedId?: InputMaybe<Scalars['ID']['input']>;
+  folderId?: InputMaybe<Scalars['ID']['input']>;
+};
+
+
+/** Root subscription type for receiving live updates */
+export type SubscriptionFeedUpdatedArgs = {
+  feedId?: InputMaybe<Scalars['ID']['input']>;
+};
+
+
+/** Root subscription type for receiving live updates */
+export type SubscriptionRefreshJobUpdatedArgs = {
+  id: Scalars['ID']['input'];
+};
+
+/** Input for updating feed settings. Null fields are left unchanged. */
+export type UpdateFeedInput = {
+  /** Replaces the credentials of the feed. Pass an input with no fields set to remove them. */
+  credentials?: InputMaybe<FeedCredentialsInput>;
+  /** Whether the full content of new articles is extracted from their pages */
+  fetchFullContent?: InputMaybe<Scalars['Boolean']['input']>;
+  /** Timeout in seconds of requests fetching the feed. Pass 0 to use the server's default. */
+  fetchTimeoutSeconds?: InputMaybe<Scalars['Int']['input']>;
+};
+
 /** Represents a user in the system */
 export type User = {
   /** Unique identifier for the user */
@@ -214,7 +645,7 @@ export type LogoutMutation = { logout: boolean };
 export type GetFeedsQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type GetFeedsQuery = { feeds: Array<{ id: string, url: string, title: string, fetchedAt: string, isSubscribed: boolean, articles: Array<{ id: string, isRead: boolean }> }> };
+export type GetFeedsQuery = { feeds: Array<{ id: string, url: string, title: string, fetchedAt: string, isSubscribed: boolean, iconUrl?: string | null, articles: Array<{ id: string, isRead: boolean }> }> };
 
 export type GetUnreadArticlesQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -254,7 +685,7 @@ export const MarkFeedReadDocument = {"kind":"Document","definitions":[{"kind":"O
 export const MarkFeedUnreadDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"MarkFeedUnread"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"markFeedUnread"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"fetchedAt"}}]}}]}}]} as unknown as DocumentNode<MarkFeedUnreadMutation, MarkFeedUnreadMutationVariables>;
 export const LoginDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"Login"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"username"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"password"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"login"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"username"},"value":{"kind":"Variable","name":{"kind":"Name","value":"username"}}},{"kind":"Argument","name":{"kind":"Name","value":"password"},"value":{"kind":"Variable","name":{"kind":"Name","value":"password"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"user"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"username"}}]}}]}}]}}]} as unknown as DocumentNode<LoginMutation, LoginMutationVariables>;
 export const LogoutDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"Logout"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"logout"}}]}}]} as unknown as DocumentNode<LogoutMutation, LogoutMutationVariables>;
-export const GetFeedsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFeeds"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"feeds"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"fetchedAt"}},{"kind":"Field","name":{"kind":"Name","value":"isSubscribed"}},{"kind":"Field","name":{"kind":"Name","value":"articles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"isRead"}}]}}]}}]}}]} as unknown as DocumentNode<GetFeedsQuery, GetFeedsQueryVariables>;
+export const GetFeedsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFeeds"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"feeds"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"fetchedAt"}},{"kind":"Field","name":{"kind":"Name","value":"isSubscribed"}},{"kind":"Field","name":{"kind":"Name","value":"iconUrl"}},{"kind":"Field","name":{"kind":"Name","value":"articles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"isRead"}}]}}]}}]}}]} as unknown as DocumentNode<GetFeedsQuery, GetFeedsQueryVariables>;
 export const GetUnreadArticlesDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetUnreadArticles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"unreadArticles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"feedId"}},{"kind":"Field","name":{"kind":"Name","value":"guid"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"isRead"}},{"kind":"Field","name":{"kind":"Name","value":"feed"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"isSubscribed"}}]}}]}}]}}]} as unknown as DocumentNode<GetUnreadArticlesQuery, GetUnreadArticlesQueryVariables>;
 export const GetReadArticlesDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetReadArticles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"readArticles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"feedId"}},{"kind":"Field","name":{"kind":"Name","value":"guid"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"isRead"}},{"kind":"Field","name":{"kind":"Name","value":"feed"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"isSubscribed"}}]}}]}}]}}]} as unknown as DocumentNode<GetReadArticlesQuery, GetReadArticlesQueryVariables>;
 export const GetFeedDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFeed"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"feed"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"fetchedAt"}},{"kind":"Field","name":{"kind":"Name","value":"isSubscribed"}},{"kind":"Field","name":{"kind":"Name","value":"articles"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"guid"}},{"kind":"Field","name":{"kind":"Name","value":"title"}},{"kind":"Field","name":{"kind":"Name","value":"url"}},{"kind":"Field","name":{"kind":"Name","value":"isRead"}}]}}]}}]}}]} as unknown as DocumentNode<GetFeedQuery, GetFeedQueryVariables>;
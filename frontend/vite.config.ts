@@ -43,6 +43,7 @@ export default defineConfig({
 			"/graphql": {
 				target: "http://localhost:8080",
 				changeOrigin: true,
+				ws: true,
 			},
 		},
 		hmr: {